@@ -27,9 +27,9 @@ func (c *Client) CreateAlias(ctx context.Context, request CreateAliasRequest, de
 	}
 	payload := string(mustMarshal(request))
 
-	tflog.Trace(ctx, "creating alias", map[string]interface{}{
+	tflog.Trace(c.logContext(ctx), "creating alias", map[string]interface{}{
 		"url":     url,
-		"payload": payload,
+		"payload": redactPayload(payload),
 	})
 	var aliasResponse createAliasResponse
 	err = c.doRequest(clientRequest{
@@ -37,6 +37,8 @@ func (c *Client) CreateAlias(ctx context.Context, request CreateAliasRequest, de
 		method: "POST",
 		url:    url,
 		body:   payload,
+		// Re-assigning an alias to the same deployment is a no-op, so this is safe to retry.
+		idempotent: true,
 	}, &aliasResponse)
 	if err != nil {
 		return r, err
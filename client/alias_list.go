@@ -0,0 +1,16 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// ListAliases lists all of the aliases within Vercel that match the filters.
+func (c *Client) ListAliases(ctx context.Context, teamID string, filters ListFilters) (r []AliasResponse, err error) {
+	url := fmt.Sprintf("%s/v4/aliases", c.baseURL)
+	r, err = listAll[AliasResponse](ctx, c, url, c.listQuery(teamID, filters), "aliases")
+	for i := range r {
+		r[i].TeamID = c.teamID(teamID)
+	}
+	return r, err
+}
@@ -2,35 +2,103 @@ package client
 
 import (
 	"net/http"
+	"strings"
 	"time"
 )
 
+// DefaultUserAgent is the User-Agent sent with requests by clients that have not been configured otherwise.
+const DefaultUserAgent = "terraform-provider-vercel"
+
 // Client is an API wrapper, providing a high-level interface to the Vercel API.
 type Client struct {
-	token   string
-	client  *http.Client
-	_teamID string
-	baseURL string
+	token       string
+	client      *http.Client
+	_teamID     string
+	baseURL     string
+	retryPolicy RetryPolicy
+	transport   http.RoundTripper
+	timeout     time.Duration
+	userAgent   string
+	headers     map[string]string
 }
 
 func (c *Client) http() *http.Client {
 	if c.client == nil {
 		c.client = &http.Client{
-			// Hopefully it doesn't take more than 5 minutes
-			// to upload a single file for a deployment.
-			Timeout: 5 * 60 * time.Second,
+			Transport: c.transport,
+			Timeout:   c.timeout,
 		}
 	}
 
 	return c.client
 }
 
+// Option configures optional behaviour of a Client.
+type Option func(*Client)
+
+// WithBaseURL points the client at a different Vercel API host. This is
+// mostly useful for directing requests to a local mock of the API.
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		c.baseURL = strings.TrimSuffix(baseURL, "/")
+	}
+}
+
+// WithTransport sets the http.RoundTripper used to make requests, e.g. to route
+// requests through a proxy. By default, http.DefaultTransport is used.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(c *Client) {
+		c.transport = transport
+	}
+}
+
+// WithTimeout sets the maximum amount of time a single HTTP request to Vercel may take.
+// Retried requests are subject to the timeout for each attempt.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.timeout = timeout
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithHeaders sets additional headers that are sent with every request.
+func WithHeaders(headers map[string]string) Option {
+	return func(c *Client) {
+		c.headers = map[string]string{}
+		for k, v := range headers {
+			c.headers[k] = v
+		}
+	}
+}
+
+// WithRetryPolicy changes how the client retries requests that fail with a transient error.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Client) {
+		c.retryPolicy = policy
+	}
+}
+
 // New creates a new instace of Client for a given API token.
-func New(token string) *Client {
-	return &Client{
-		token:   token,
-		baseURL: "https://api.vercel.com",
+func New(token string, opts ...Option) *Client {
+	c := &Client{
+		token:       token,
+		baseURL:     "https://api.vercel.com",
+		retryPolicy: DefaultRetryPolicy,
+		// Hopefully it doesn't take more than 5 minutes
+		// to upload a single file for a deployment.
+		timeout:   5 * 60 * time.Second,
+		userAgent: DefaultUserAgent,
+	}
+	for _, opt := range opts {
+		opt(c)
 	}
+	return c
 }
 
 func (c *Client) WithTeamID(teamID string) *Client {
@@ -38,6 +106,12 @@ func (c *Client) WithTeamID(teamID string) *Client {
 	return c
 }
 
+// TeamID returns the team that requests for the given teamID are made within. This is the
+// teamID itself if it is set, or otherwise the team configured on the client.
+func (c *Client) TeamID(teamID string) string {
+	return c.teamID(teamID)
+}
+
 // teamID is a helper method to return one of two values based on specificity.
 // It will return an explicitly passed teamID if it is defined. If not defined,
 // it will fall back to the teamID configured on the client.
@@ -0,0 +1,91 @@
+package clienttest
+
+import (
+	"net/http"
+	"sort"
+)
+
+type alias struct {
+	UID          string `json:"uid"`
+	Alias        string `json:"alias"`
+	DeploymentID string `json:"deploymentId"`
+	CreatedAt    int64  `json:"createdAt"`
+
+	teamID string
+}
+
+func (s *Server) findAlias(r *http.Request, aliasOrID string) *alias {
+	for _, a := range s.aliases {
+		if a.teamID == teamID(r) && (a.UID == aliasOrID || a.Alias == aliasOrID) {
+			return a
+		}
+	}
+	return nil
+}
+
+func (s *Server) createAlias(w http.ResponseWriter, r *http.Request, params []string) {
+	d := s.findDeployment(r, params[0])
+	if d == nil {
+		writeNotFound(w, "Deployment")
+		return
+	}
+	var req struct {
+		Alias string `json:"alias"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.Alias == "" {
+		writeError(w, http.StatusBadRequest, "bad_request", "alias is required")
+		return
+	}
+	// Assigning an existing alias to a new deployment moves it.
+	a := s.findAlias(r, req.Alias)
+	if a == nil {
+		a = &alias{
+			UID:       randomString(24),
+			Alias:     req.Alias,
+			CreatedAt: s.now(),
+			teamID:    teamID(r),
+		}
+		s.aliases[a.UID] = a
+	}
+	a.DeploymentID = d.ID
+	writeJSON(w, http.StatusOK, a)
+}
+
+func (s *Server) getAlias(w http.ResponseWriter, r *http.Request, params []string) {
+	a := s.findAlias(r, params[0])
+	if a == nil {
+		writeNotFound(w, "Alias")
+		return
+	}
+	writeJSON(w, http.StatusOK, a)
+}
+
+func (s *Server) deleteAlias(w http.ResponseWriter, r *http.Request, params []string) {
+	a := s.findAlias(r, params[0])
+	if a == nil {
+		writeNotFound(w, "Alias")
+		return
+	}
+	delete(s.aliases, a.UID)
+	writeJSON(w, http.StatusOK, map[string]string{
+		"status": "SUCCESS",
+	})
+}
+
+func (s *Server) listAliases(w http.ResponseWriter, r *http.Request, _ []string) {
+	aliases := []*alias{}
+	for _, a := range s.aliases {
+		if a.teamID == teamID(r) {
+			aliases = append(aliases, a)
+		}
+	}
+	sort.Slice(aliases, func(i, j int) bool {
+		return aliases[i].CreatedAt > aliases[j].CreatedAt
+	})
+	writePage(w, r, "aliases", aliases, func(a *alias) int64 {
+		return a.CreatedAt
+	})
+}
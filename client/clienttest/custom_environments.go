@@ -0,0 +1,200 @@
+package clienttest
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+type branchMatcher struct {
+	Type    string `json:"type"`
+	Pattern string `json:"pattern"`
+}
+
+type customEnvironmentDomain struct {
+	Name string `json:"name"`
+}
+
+type customEnvironment struct {
+	ID            string                    `json:"id"`
+	Slug          string                    `json:"slug"`
+	Type          string                    `json:"type"`
+	Description   string                    `json:"description"`
+	BranchMatcher *branchMatcher            `json:"branchMatcher"`
+	Domains       []customEnvironmentDomain `json:"domains"`
+}
+
+var invalidSlugCharacters = regexp.MustCompile(`[^a-z0-9]+`)
+
+// slugify converts the name of a custom environment into a slug, as Vercel does.
+func slugify(name string) string {
+	return strings.Trim(invalidSlugCharacters.ReplaceAllString(strings.ToLower(name), "-"), "-")
+}
+
+func validBranchMatcher(m *branchMatcher) bool {
+	if m == nil {
+		return true
+	}
+	switch m.Type {
+	case "equals", "startsWith", "endsWith":
+		return m.Pattern != ""
+	}
+	return false
+}
+
+// findCustomEnvironment looks up a custom environment of a project by ID or slug.
+func (p *project) findCustomEnvironment(idOrSlug string) *customEnvironment {
+	for _, e := range p.customEnvironments {
+		if e.ID == idOrSlug || e.Slug == idOrSlug {
+			return e
+		}
+	}
+	return nil
+}
+
+// withDomains returns a copy of the custom environment including the project domains assigned to it.
+func (p *project) withDomains(e *customEnvironment) customEnvironment {
+	c := *e
+	c.Domains = []customEnvironmentDomain{}
+	for _, d := range p.domains {
+		if d.CustomEnvironmentID != nil && *d.CustomEnvironmentID == e.ID {
+			c.Domains = append(c.Domains, customEnvironmentDomain{Name: d.Name})
+		}
+	}
+	return c
+}
+
+func (s *Server) createCustomEnvironment(w http.ResponseWriter, r *http.Request, params []string) {
+	p := s.findProject(r, params[0])
+	if p == nil {
+		writeNotFound(w, "Project")
+		return
+	}
+	var req struct {
+		Slug          string         `json:"slug"`
+		Description   string         `json:"description"`
+		BranchMatcher *branchMatcher `json:"branchMatcher"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	slug := slugify(req.Slug)
+	switch slug {
+	case "":
+		writeValidationError(w, "slug", "Invalid request: `slug` is required")
+		return
+	case "production", "preview", "development":
+		writeError(w, http.StatusBadRequest, "bad_request", fmt.Sprintf("The custom environment name %s is reserved", slug))
+		return
+	}
+	if p.findCustomEnvironment(slug) != nil {
+		writeError(w, http.StatusConflict, "conflict", fmt.Sprintf("A custom environment with the slug %s already exists", slug))
+		return
+	}
+	if !validBranchMatcher(req.BranchMatcher) {
+		writeValidationError(w, "branchMatcher", "Invalid request: `branchMatcher` is invalid")
+		return
+	}
+	e := &customEnvironment{
+		ID:            newID("env_"),
+		Slug:          slug,
+		Type:          "preview",
+		Description:   req.Description,
+		BranchMatcher: req.BranchMatcher,
+	}
+	p.customEnvironments = append(p.customEnvironments, e)
+	writeJSON(w, http.StatusCreated, p.withDomains(e))
+}
+
+func (s *Server) getCustomEnvironment(w http.ResponseWriter, r *http.Request, params []string) {
+	p := s.findProject(r, params[0])
+	if p == nil {
+		writeNotFound(w, "Project")
+		return
+	}
+	e := p.findCustomEnvironment(params[1])
+	if e == nil {
+		writeNotFound(w, "Custom Environment")
+		return
+	}
+	writeJSON(w, http.StatusOK, p.withDomains(e))
+}
+
+func (s *Server) updateCustomEnvironment(w http.ResponseWriter, r *http.Request, params []string) {
+	p := s.findProject(r, params[0])
+	if p == nil {
+		writeNotFound(w, "Project")
+		return
+	}
+	e := p.findCustomEnvironment(params[1])
+	if e == nil {
+		writeNotFound(w, "Custom Environment")
+		return
+	}
+	var req struct {
+		Slug          string         `json:"slug"`
+		Description   string         `json:"description"`
+		BranchMatcher *branchMatcher `json:"branchMatcher"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	slug := slugify(req.Slug)
+	if slug == "" {
+		slug = e.Slug
+	}
+	if existing := p.findCustomEnvironment(slug); existing != nil && existing != e {
+		writeError(w, http.StatusConflict, "conflict", fmt.Sprintf("A custom environment with the slug %s already exists", slug))
+		return
+	}
+	if !validBranchMatcher(req.BranchMatcher) {
+		writeValidationError(w, "branchMatcher", "Invalid request: `branchMatcher` is invalid")
+		return
+	}
+	e.Slug, e.Description, e.BranchMatcher = slug, req.Description, req.BranchMatcher
+	writeJSON(w, http.StatusOK, p.withDomains(e))
+}
+
+func (s *Server) deleteCustomEnvironment(w http.ResponseWriter, r *http.Request, params []string) {
+	p := s.findProject(r, params[0])
+	if p == nil {
+		writeNotFound(w, "Project")
+		return
+	}
+	for i, e := range p.customEnvironments {
+		if e.ID != params[1] && e.Slug != params[1] {
+			continue
+		}
+		p.customEnvironments = append(p.customEnvironments[:i], p.customEnvironments[i+1:]...)
+		// Environment variables and domains that only applied to the environment are removed with it.
+		envs := p.envs[:0]
+		for _, v := range p.envs {
+			v.CustomEnvironmentIDs = remove(v.CustomEnvironmentIDs, e.ID)
+			if len(v.Target) > 0 || len(v.CustomEnvironmentIDs) > 0 {
+				envs = append(envs, v)
+			}
+		}
+		p.envs = envs
+		domains := p.domains[:0]
+		for _, d := range p.domains {
+			if d.CustomEnvironmentID == nil || *d.CustomEnvironmentID != e.ID {
+				domains = append(domains, d)
+			}
+		}
+		p.domains = domains
+		writeJSON(w, http.StatusOK, e)
+		return
+	}
+	writeNotFound(w, "Custom Environment")
+}
+
+func remove(items []string, item string) []string {
+	out := []string{}
+	for _, i := range items {
+		if i != item {
+			out = append(out, i)
+		}
+	}
+	return out
+}
@@ -0,0 +1,363 @@
+package clienttest
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+type deploymentError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+type gitSource struct {
+	Type      string `json:"type"`
+	Org       string `json:"org,omitempty"`
+	Repo      string `json:"repo,omitempty"`
+	ProjectID int64  `json:"projectId,omitempty"`
+	Owner     string `json:"owner,omitempty"`
+	Slug      string `json:"slug,omitempty"`
+	Ref       string `json:"ref"`
+}
+
+type deploymentFile struct {
+	File string `json:"file"`
+	Sha  string `json:"sha"`
+	Size int    `json:"size"`
+}
+
+type deployment struct {
+	Aliases       []string         `json:"alias"`
+	AliasAssigned bool             `json:"aliasAssigned"`
+	AliasError    *deploymentError `json:"aliasError"`
+	Creator       struct {
+		UID      string `json:"uid"`
+		Username string `json:"username"`
+	} `json:"creator"`
+	Build struct {
+		Environment []string `json:"env"`
+	} `json:"build"`
+	ErrorCode         string  `json:"errorCode,omitempty"`
+	ErrorMessage      string  `json:"errorMessage,omitempty"`
+	ID                string  `json:"id"`
+	ProjectID         string  `json:"projectId"`
+	ReadyState        string  `json:"readyState"`
+	Target            *string `json:"target"`
+	CustomEnvironment *struct {
+		ID   string `json:"id"`
+		Slug string `json:"slug"`
+	} `json:"customEnvironment,omitempty"`
+	URL       string     `json:"url"`
+	GitSource *gitSource `json:"gitSource,omitempty"`
+	CreatedAt int64      `json:"createdAt"`
+	// Meta contains details of the git commit the deployment was built from, if any.
+	Meta map[string]string `json:"meta"`
+
+	name         string
+	teamID       string
+	pendingPolls int
+	behaviour    DeploymentBehaviour
+	files        []deploymentFile
+}
+
+// progress moves a deployment one step closer to its final state. Deployments report
+// themselves as BUILDING until the configured number of polls has been reached.
+func (d *deployment) progress() {
+	if d.pendingPolls > 0 {
+		d.pendingPolls--
+		d.ReadyState = "BUILDING"
+		return
+	}
+	d.ReadyState = d.behaviour.ReadyState
+	switch d.ReadyState {
+	case "", "READY":
+		d.ReadyState = "READY"
+		d.AliasAssigned = true
+	case "ERROR":
+		d.ErrorCode = d.behaviour.ErrorCode
+		d.ErrorMessage = d.behaviour.ErrorMessage
+		if d.ErrorCode == "" {
+			d.ErrorCode = "BUILD_FAILED"
+		}
+		if d.ErrorMessage == "" {
+			d.ErrorMessage = "The build failed"
+		}
+	}
+}
+
+func (s *Server) findDeployment(r *http.Request, idOrURL string) *deployment {
+	for _, d := range s.deployments {
+		if d.teamID == teamID(r) && (d.ID == idOrURL || d.URL == idOrURL) {
+			return d
+		}
+	}
+	return nil
+}
+
+func (s *Server) createFile(w http.ResponseWriter, r *http.Request, _ []string) {
+	digest := r.Header.Get("x-vercel-digest")
+	if digest == "" {
+		writeError(w, http.StatusBadRequest, "missing_digest", "The x-vercel-digest header is required")
+		return
+	}
+	h := sha1.New()
+	size, err := io.Copy(h, r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", "Unable to read file contents")
+		return
+	}
+	if hex.EncodeToString(h.Sum(nil)) != digest {
+		writeError(w, http.StatusBadRequest, "invalid_digest", "The file SHA does not match the x-vercel-digest header")
+		return
+	}
+	s.files[digest] = int(size)
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"urls": []string{},
+	})
+}
+
+// HasFile reports whether a file with the given SHA has been uploaded to the fake server.
+func (s *Server) HasFile(sha string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.files[sha]
+	return ok
+}
+
+func (s *Server) createDeployment(w http.ResponseWriter, r *http.Request, _ []string) {
+	var req struct {
+		Files           []deploymentFile  `json:"files"`
+		Environment     map[string]string `json:"env"`
+		ProjectID       string            `json:"project"`
+		Name            string            `json:"name"`
+		Target          string            `json:"target"`
+		GitSource       *gitSource        `json:"gitSource"`
+		ProjectSettings interface{}       `json:"projectSettings"`
+		// CustomEnvironmentSlugOrID is the ID or slug of a custom environment to deploy to.
+		CustomEnvironmentSlugOrID string `json:"customEnvironmentSlugOrId"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	p := s.findProject(r, req.ProjectID)
+	if p == nil {
+		writeNotFound(w, "Project")
+		return
+	}
+	var customEnvironment *customEnvironment
+	if req.CustomEnvironmentSlugOrID != "" {
+		customEnvironment = p.findCustomEnvironment(req.CustomEnvironmentSlugOrID)
+		if customEnvironment == nil {
+			writeNotFound(w, "Custom Environment")
+			return
+		}
+		if req.Target != "" {
+			writeError(w, http.StatusBadRequest, "bad_request", "A deployment cannot have both a target and a custom environment")
+			return
+		}
+	}
+	if req.GitSource == nil && len(req.Files) == 0 {
+		writeError(w, http.StatusBadRequest, "bad_request", "Either files or a gitSource must be specified")
+		return
+	}
+
+	missing := []string{}
+	for _, f := range req.Files {
+		if _, ok := s.files[f.Sha]; !ok {
+			missing = append(missing, f.Sha)
+		}
+	}
+	if len(missing) > 0 {
+		writeJSON(w, http.StatusBadRequest, map[string]interface{}{
+			"error": map[string]interface{}{
+				"code":    "missing_files",
+				"message": "Missing files",
+				"missing": missing,
+			},
+		})
+		return
+	}
+
+	id := randomString(24)
+	d := &deployment{
+		ID:           "dpl_" + id,
+		ProjectID:    p.ID,
+		URL:          fmt.Sprintf("%s-%s.vercel.app", p.Name, strings.ToLower(id[:9])),
+		GitSource:    req.GitSource,
+		name:         p.Name,
+		teamID:       teamID(r),
+		behaviour:    s.deploymentBehaviour,
+		pendingPolls: s.deploymentBehaviour.PendingPolls,
+		files:        req.Files,
+	}
+	d.CreatedAt = s.now()
+	d.Meta = map[string]string{}
+	if req.GitSource != nil {
+		sha := sha1.Sum([]byte(d.ID))
+		d.Meta[req.GitSource.Type+"CommitRef"] = req.GitSource.Ref
+		d.Meta[req.GitSource.Type+"CommitSha"] = hex.EncodeToString(sha[:])
+		if req.GitSource.Org != "" {
+			d.Meta[req.GitSource.Type+"CommitOrg"] = req.GitSource.Org
+			d.Meta[req.GitSource.Type+"CommitRepo"] = req.GitSource.Repo
+		}
+	}
+	d.Creator.UID = "usr_terraform"
+	d.Creator.Username = "terraform"
+	d.Build.Environment = []string{}
+	for k := range req.Environment {
+		d.Build.Environment = append(d.Build.Environment, k)
+	}
+	if req.Target != "" {
+		d.Target = &req.Target
+	}
+	d.Aliases = []string{d.URL}
+	if req.Target == "production" {
+		d.Aliases = append(d.Aliases, fmt.Sprintf("%s.vercel.app", p.Name))
+		for _, pd := range p.domains {
+			if pd.GitBranch == nil && pd.Redirect == nil && pd.CustomEnvironmentID == nil {
+				d.Aliases = append(d.Aliases, pd.Name)
+			}
+		}
+	}
+	if customEnvironment != nil {
+		d.CustomEnvironment = &struct {
+			ID   string `json:"id"`
+			Slug string `json:"slug"`
+		}{ID: customEnvironment.ID, Slug: customEnvironment.Slug}
+		for _, pd := range p.withDomains(customEnvironment).Domains {
+			d.Aliases = append(d.Aliases, pd.Name)
+		}
+	}
+	d.progress()
+	s.deployments[d.ID] = d
+	writeJSON(w, http.StatusOK, d)
+}
+
+func (s *Server) getDeployment(w http.ResponseWriter, r *http.Request, params []string) {
+	d := s.findDeployment(r, params[0])
+	if d == nil {
+		writeNotFound(w, "Deployment")
+		return
+	}
+	if d.ReadyState == "BUILDING" {
+		d.progress()
+	}
+	writeJSON(w, http.StatusOK, d)
+}
+
+func (s *Server) deleteDeployment(w http.ResponseWriter, r *http.Request, params []string) {
+	d := s.findDeployment(r, params[0])
+	if d == nil {
+		writeNotFound(w, "Deployment")
+		return
+	}
+	delete(s.deployments, d.ID)
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"uid":   d.ID,
+		"state": "DELETED",
+	})
+}
+
+func (s *Server) cancelDeployment(w http.ResponseWriter, r *http.Request, params []string) {
+	d := s.findDeployment(r, params[0])
+	if d == nil {
+		writeNotFound(w, "Deployment")
+		return
+	}
+	if d.ReadyState != "BUILDING" && d.ReadyState != "CANCELED" {
+		writeError(w, http.StatusBadRequest, "deployment_not_cancelable", "Only deployments that are building can be cancelled")
+		return
+	}
+	d.ReadyState = "CANCELED"
+	d.pendingPolls = 0
+	writeJSON(w, http.StatusOK, d)
+}
+
+func (s *Server) getDeploymentEvents(w http.ResponseWriter, r *http.Request, params []string) {
+	d := s.findDeployment(r, params[0])
+	if d == nil {
+		writeNotFound(w, "Deployment")
+		return
+	}
+	lines := d.behaviour.BuildLogs
+	if lines == nil {
+		lines = []string{
+			"Cloning completed",
+			"Running \"vercel build\"",
+			"Build Completed in /vercel/output",
+		}
+	}
+	events := []map[string]interface{}{}
+	for i, line := range lines {
+		events = append(events, map[string]interface{}{
+			"type":    "stdout",
+			"created": i,
+			"payload": map[string]interface{}{
+				"deploymentId": d.ID,
+				"text":         line,
+			},
+		})
+	}
+	writeJSON(w, http.StatusOK, events)
+}
+
+// summary returns the deployment in the format used when listing deployments.
+func (d *deployment) summary() map[string]interface{} {
+	return map[string]interface{}{
+		"uid":     d.ID,
+		"name":    d.name,
+		"url":     d.URL,
+		"created": d.CreatedAt,
+		"state":   d.ReadyState,
+		"target":  d.Target,
+		"creator": d.Creator,
+		"meta":    d.Meta,
+	}
+}
+
+func (s *Server) listDeployments(w http.ResponseWriter, r *http.Request, _ []string) {
+	query := r.URL.Query()
+	deployments := []*deployment{}
+	for _, d := range s.deployments {
+		if d.teamID != teamID(r) {
+			continue
+		}
+		if projectID := query.Get("projectId"); projectID != "" && d.ProjectID != projectID {
+			continue
+		}
+		if target := query.Get("target"); target == "production" && (d.Target == nil || *d.Target != "production") {
+			continue
+		} else if target == "preview" && d.Target != nil {
+			continue
+		}
+		if state := query.Get("state"); state != "" && !strings.Contains(","+state+",", ","+d.ReadyState+",") {
+			continue
+		}
+		deployments = append(deployments, d)
+	}
+	sort.Slice(deployments, func(i, j int) bool {
+		return deployments[i].CreatedAt > deployments[j].CreatedAt
+	})
+	summaries := []map[string]interface{}{}
+	for _, d := range deployments {
+		summaries = append(summaries, d.summary())
+	}
+	writePage(w, r, "deployments", summaries, func(d map[string]interface{}) int64 {
+		return d["created"].(int64)
+	})
+}
+
+// DeploymentState returns the current readyState of a deployment, without progressing it.
+func (s *Server) DeploymentState(id string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if d, ok := s.deployments[id]; ok {
+		return d.ReadyState
+	}
+	return ""
+}
@@ -0,0 +1,210 @@
+package clienttest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type srv struct {
+	Port     int64  `json:"port"`
+	Priority int64  `json:"priority"`
+	Target   string `json:"target"`
+	Weight   int64  `json:"weight"`
+}
+
+type dnsRecord struct {
+	Creator    string `json:"creator"`
+	Domain     string `json:"domain"`
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	TTL        int64  `json:"ttl"`
+	Value      string `json:"value"`
+	RecordType string `json:"recordType"`
+	CreatedAt  int64  `json:"createdAt"`
+
+	teamID string
+}
+
+// recordValue builds the value Vercel reports for a record, which combines
+// the value with any MX or SRV specific fields.
+func recordValue(recordType, value string, mxPriority int64, s *srv) string {
+	switch recordType {
+	case "MX":
+		return fmt.Sprintf("%d %s", mxPriority, value)
+	case "SRV":
+		if s == nil {
+			return ""
+		}
+		return strings.TrimSpace(fmt.Sprintf("%d %d %d %s", s.Priority, s.Weight, s.Port, s.Target))
+	}
+	return value
+}
+
+func (s *Server) findDNSRecord(r *http.Request, id string) *dnsRecord {
+	record, ok := s.dnsRecords[id]
+	if !ok || record.teamID != teamID(r) {
+		return nil
+	}
+	return record
+}
+
+func (s *Server) createDNSRecord(w http.ResponseWriter, r *http.Request, params []string) {
+	var req struct {
+		MXPriority int64  `json:"mxPriority"`
+		Name       string `json:"name"`
+		SRV        *srv   `json:"srv"`
+		TTL        int64  `json:"ttl"`
+		Type       string `json:"type"`
+		Value      string `json:"value"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	switch req.Type {
+	case "A", "AAAA", "ALIAS", "CAA", "CNAME", "MX", "NS", "TXT":
+		if req.Value == "" {
+			writeError(w, http.StatusBadRequest, "invalid_value", "A value is required")
+			return
+		}
+	case "SRV":
+		if req.SRV == nil {
+			writeError(w, http.StatusBadRequest, "invalid_value", "An srv block is required")
+			return
+		}
+	default:
+		writeError(w, http.StatusBadRequest, "invalid_type", fmt.Sprintf("Invalid record type %q", req.Type))
+		return
+	}
+	if req.TTL == 0 {
+		req.TTL = 60
+	}
+	record := &dnsRecord{
+		Creator:    "terraform",
+		Domain:     params[0],
+		ID:         newID("rec_"),
+		Name:       req.Name,
+		TTL:        req.TTL,
+		Value:      recordValue(req.Type, req.Value, req.MXPriority, req.SRV),
+		RecordType: req.Type,
+		CreatedAt:  s.now(),
+		teamID:     teamID(r),
+	}
+	s.dnsRecords[record.ID] = record
+	writeJSON(w, http.StatusOK, map[string]string{
+		"uid": record.ID,
+	})
+}
+
+func (s *Server) getDNSRecord(w http.ResponseWriter, r *http.Request, params []string) {
+	record := s.findDNSRecord(r, params[0])
+	if record == nil {
+		writeNotFound(w, "DNS Record")
+		return
+	}
+	writeJSON(w, http.StatusOK, record)
+}
+
+func (s *Server) listDNSRecords(w http.ResponseWriter, r *http.Request, params []string) {
+	records := []*dnsRecord{}
+	for _, record := range s.dnsRecords {
+		if record.Domain == params[0] && record.teamID == teamID(r) {
+			records = append(records, record)
+		}
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].CreatedAt > records[j].CreatedAt
+	})
+	writePage(w, r, "records", records, func(record *dnsRecord) int64 {
+		return record.CreatedAt
+	})
+}
+
+// splitRecordValue extracts the MX priority or SRV fields that are combined into a record value.
+func splitRecordValue(record *dnsRecord) (value string, mxPriority int64, s *srv) {
+	parts := strings.Split(record.Value, " ")
+	switch record.RecordType {
+	case "MX":
+		if len(parts) == 2 {
+			mxPriority, _ = strconv.ParseInt(parts[0], 10, 64)
+			return parts[1], mxPriority, nil
+		}
+	case "SRV":
+		s = &srv{}
+		if len(parts) >= 3 {
+			s.Priority, _ = strconv.ParseInt(parts[0], 10, 64)
+			s.Weight, _ = strconv.ParseInt(parts[1], 10, 64)
+			s.Port, _ = strconv.ParseInt(parts[2], 10, 64)
+		}
+		if len(parts) == 4 {
+			s.Target = parts[3]
+		}
+		return "", 0, s
+	}
+	return record.Value, 0, nil
+}
+
+func (s *Server) updateDNSRecord(w http.ResponseWriter, r *http.Request, params []string) {
+	record := s.findDNSRecord(r, params[0])
+	if record == nil {
+		writeNotFound(w, "DNS Record")
+		return
+	}
+	var req struct {
+		MXPriority *int64  `json:"mxPriority"`
+		Name       *string `json:"name"`
+		SRV        *struct {
+			Port     *int64  `json:"port"`
+			Priority *int64  `json:"priority"`
+			Target   *string `json:"target"`
+			Weight   *int64  `json:"weight"`
+		} `json:"srv"`
+		TTL   *int64  `json:"ttl"`
+		Value *string `json:"value"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	value, mxPriority, srvFields := splitRecordValue(record)
+	if req.Name != nil {
+		record.Name = *req.Name
+	}
+	if req.TTL != nil {
+		record.TTL = *req.TTL
+	}
+	if req.Value != nil {
+		value = *req.Value
+	}
+	if req.MXPriority != nil {
+		mxPriority = *req.MXPriority
+	}
+	if req.SRV != nil && srvFields != nil {
+		if req.SRV.Port != nil {
+			srvFields.Port = *req.SRV.Port
+		}
+		if req.SRV.Priority != nil {
+			srvFields.Priority = *req.SRV.Priority
+		}
+		if req.SRV.Target != nil {
+			srvFields.Target = *req.SRV.Target
+		}
+		if req.SRV.Weight != nil {
+			srvFields.Weight = *req.SRV.Weight
+		}
+	}
+	record.Value = recordValue(record.RecordType, value, mxPriority, srvFields)
+	writeJSON(w, http.StatusOK, record)
+}
+
+func (s *Server) deleteDNSRecord(w http.ResponseWriter, r *http.Request, params []string) {
+	record := s.findDNSRecord(r, params[1])
+	if record == nil || record.Domain != params[0] {
+		writeNotFound(w, "DNS Record")
+		return
+	}
+	delete(s.dnsRecords, record.ID)
+	writeJSON(w, http.StatusOK, json.RawMessage("{}"))
+}
@@ -0,0 +1,177 @@
+package clienttest
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type domain struct {
+	ID                  string   `json:"id"`
+	Name                string   `json:"name"`
+	ServiceType         string   `json:"serviceType"`
+	Verified            bool     `json:"verified"`
+	Nameservers         []string `json:"nameservers"`
+	IntendedNameservers []string `json:"intendedNameservers"`
+	VerificationRecord  string   `json:"verificationRecord"`
+	CreatedAt           int64    `json:"createdAt"`
+
+	teamID string
+}
+
+var vercelNameservers = []string{"ns1.vercel-dns.com", "ns2.vercel-dns.com"}
+
+func (s *Server) findDomain(r *http.Request, name string) *domain {
+	d, ok := s.domains[name]
+	if !ok || d.teamID != teamID(r) {
+		return nil
+	}
+	return d
+}
+
+// VerifyDomain marks a domain as verified, as if its nameservers had been updated to Vercel's.
+func (s *Server) VerifyDomain(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if d, ok := s.domains[name]; ok {
+		d.Verified = true
+		d.Nameservers = d.IntendedNameservers
+	}
+}
+
+func (s *Server) createDomain(w http.ResponseWriter, r *http.Request, _ []string) {
+	var req struct {
+		Name   string `json:"name"`
+		Method string `json:"method"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	if !strings.Contains(req.Name, ".") {
+		writeValidationError(w, "name", fmt.Sprintf("Invalid request: `name` %q is not a valid domain", req.Name))
+		return
+	}
+	if req.Method != "add" {
+		writeValidationError(w, "method", "Invalid request: `method` should be equal to one of the allowed values")
+		return
+	}
+	if _, ok := s.domains[req.Name]; ok {
+		writeError(w, http.StatusConflict, "not_modified", "The domain is already in use by an account")
+		return
+	}
+	d := &domain{
+		ID:                  newID(""),
+		Name:                req.Name,
+		ServiceType:         "zeit.world",
+		Nameservers:         []string{"ns1.example-registrar.com", "ns2.example-registrar.com"},
+		IntendedNameservers: vercelNameservers,
+		VerificationRecord:  "vc-domain-verify=" + req.Name + "," + randomString(20),
+		teamID:              teamID(r),
+	}
+	d.CreatedAt = s.now()
+	s.domains[d.Name] = d
+	writeJSON(w, http.StatusOK, map[string]interface{}{"domain": d})
+}
+
+func (s *Server) getDomain(w http.ResponseWriter, r *http.Request, params []string) {
+	d := s.findDomain(r, params[0])
+	if d == nil {
+		writeNotFound(w, "Domain")
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"domain": d})
+}
+
+func (s *Server) updateDomain(w http.ResponseWriter, r *http.Request, params []string) {
+	d := s.findDomain(r, params[0])
+	if d == nil {
+		writeNotFound(w, "Domain")
+		return
+	}
+	var req struct {
+		Op          string `json:"op"`
+		Zone        *bool  `json:"zone"`
+		Destination string `json:"destination"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	switch req.Op {
+	case "update":
+		if req.Zone != nil {
+			d.ServiceType = "external"
+			if *req.Zone {
+				d.ServiceType = "zeit.world"
+			}
+		}
+		writeJSON(w, http.StatusOK, map[string]string{"name": d.Name})
+	case "move-out":
+		t := s.findTeam(req.Destination)
+		if t == nil {
+			writeError(w, http.StatusBadRequest, "invalid_destination", "The destination team does not exist")
+			return
+		}
+		d.teamID = t.ID
+		writeJSON(w, http.StatusOK, map[string]bool{"moved": true})
+	default:
+		writeValidationError(w, "op", "Invalid request: `op` should be equal to one of the allowed values")
+	}
+}
+
+func (s *Server) deleteDomain(w http.ResponseWriter, r *http.Request, params []string) {
+	d := s.findDomain(r, params[0])
+	if d == nil {
+		writeNotFound(w, "Domain")
+		return
+	}
+	delete(s.domains, d.Name)
+	for id, record := range s.dnsRecords {
+		if record.Domain == d.Name {
+			delete(s.dnsRecords, id)
+		}
+	}
+	writeJSON(w, http.StatusOK, map[string]string{"uid": d.ID})
+}
+
+// ConfigureDomain marks a domain as having its DNS configured to point at Vercel, using the given
+// method (e.g. "A", "CNAME" or "http").
+func (s *Server) ConfigureDomain(name, configuredBy string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.domainConfigs[name] = configuredBy
+}
+
+type recommendedIPv4 struct {
+	Rank  int      `json:"rank"`
+	Value []string `json:"value"`
+}
+
+type recommendedCNAME struct {
+	Rank  int    `json:"rank"`
+	Value string `json:"value"`
+}
+
+func (s *Server) getDomainConfig(w http.ResponseWriter, r *http.Request, params []string) {
+	name := params[0]
+	if !strings.Contains(name, ".") {
+		writeValidationError(w, "domain", fmt.Sprintf("Invalid request: `domain` %q is not a valid domain", name))
+		return
+	}
+	var configuredBy *string
+	if c, ok := s.domainConfigs[name]; ok {
+		configuredBy = &c
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"configuredBy":       configuredBy,
+		"acceptedChallenges": []string{"dns-01", "http-01"},
+		"misconfigured":      configuredBy == nil,
+		"recommendedIPv4": []recommendedIPv4{
+			{Rank: 1, Value: []string{"76.76.21.21"}},
+			{Rank: 2, Value: []string{"76.76.21.22"}},
+		},
+		"recommendedCNAME": []recommendedCNAME{
+			{Rank: 1, Value: "cname.vercel-dns.com."},
+			{Rank: 2, Value: "cname-2.vercel-dns.com."},
+		},
+	})
+}
@@ -0,0 +1,437 @@
+package clienttest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type env struct {
+	ID        string   `json:"id"`
+	Key       string   `json:"key"`
+	Value     string   `json:"value"`
+	Target    []string `json:"target"`
+	GitBranch *string  `json:"gitBranch,omitempty"`
+	Type      string   `json:"type"`
+	Comment   string   `json:"comment,omitempty"`
+	// CustomEnvironmentIDs are the custom environments the environment variable applies to, in
+	// addition to its targets.
+	CustomEnvironmentIDs []string `json:"customEnvironmentIds"`
+}
+
+// encrypted returns a copy of the environment variable as Vercel returns it when values
+// have not been explicitly decrypted. Plain environment variables are never encrypted.
+func (e *env) encrypted() env {
+	c := *e
+	if e.Type != "plain" {
+		c.Value = fmt.Sprintf("encrypted:%x", e.Value)
+	}
+	return c
+}
+
+// decrypted returns a copy of the environment variable as Vercel returns it when values
+// have been decrypted. Sensitive environment variables can never be decrypted.
+func (e *env) decrypted() env {
+	c := *e
+	if e.Type == "sensitive" {
+		c.Value = ""
+	}
+	return c
+}
+
+func validEnvType(t string) bool {
+	switch t {
+	case "plain", "encrypted", "sensitive", "secret", "system":
+		return true
+	}
+	return false
+}
+
+func overlaps(a, b []string) bool {
+	for _, x := range a {
+		for _, y := range b {
+			if x == y {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func sameBranch(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+// validateEnv ensures an environment variable does not conflict with any other environment
+// variable on the project, excluding the environment variable with the ID ignore.
+func (p *project) validateEnv(e *env, ignore string) error {
+	if e.Key == "" {
+		return fmt.Errorf("environment variable key is required")
+	}
+	if len(e.Target) == 0 && len(e.CustomEnvironmentIDs) == 0 {
+		return fmt.Errorf("environment variable %s must have at least one target or custom environment", e.Key)
+	}
+	for _, id := range e.CustomEnvironmentIDs {
+		if c := p.findCustomEnvironment(id); c == nil || c.ID != id {
+			return fmt.Errorf("custom environment %s not found", id)
+		}
+	}
+	if e.Type != "" && !validEnvType(e.Type) {
+		return fmt.Errorf("environment variable %s has an invalid type %s", e.Key, e.Type)
+	}
+	for _, existing := range p.envs {
+		if existing.ID == ignore {
+			continue
+		}
+		sameEnvironment := overlaps(existing.Target, e.Target) || overlaps(existing.CustomEnvironmentIDs, e.CustomEnvironmentIDs)
+		if existing.Key == e.Key && sameEnvironment && sameBranch(existing.GitBranch, e.GitBranch) {
+			return fmt.Errorf("A variable with the name `%s` already exists for the target %v", e.Key, e.Target)
+		}
+	}
+	return nil
+}
+
+func (p *project) addEnv(e *env) error {
+	if err := p.validateEnv(e, ""); err != nil {
+		return err
+	}
+	e.ID = randomString(16)
+	if e.CustomEnvironmentIDs == nil {
+		e.CustomEnvironmentIDs = []string{}
+	}
+	if e.Type == "" {
+		e.Type = "encrypted"
+	}
+	p.envs = append(p.envs, e)
+	return nil
+}
+
+func (p *project) findEnv(id string) *env {
+	for _, e := range p.envs {
+		if e.ID == id {
+			return e
+		}
+	}
+	return nil
+}
+
+// AddEnvironmentVariable adds an environment variable directly to a project within the fake
+// server's state, bypassing the API. This is useful for simulating changes made outside of
+// Terraform. It returns the ID of the new environment variable.
+func (s *Server) AddEnvironmentVariable(projectID, key, value string, target []string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := s.projects[projectID]
+	if !ok {
+		return "", fmt.Errorf("project %s not found", projectID)
+	}
+	e := &env{
+		Key:    key,
+		Value:  value,
+		Target: target,
+	}
+	err := p.addEnv(e)
+	return e.ID, err
+}
+
+func (s *Server) listEnvironmentVariables(w http.ResponseWriter, r *http.Request, params []string) {
+	p := s.findProject(r, params[0])
+	if p == nil {
+		writeNotFound(w, "Project")
+		return
+	}
+	decrypt := r.URL.Query().Get("decrypt") == "true"
+	envs := []env{}
+	for _, e := range p.envs {
+		if decrypt {
+			envs = append(envs, e.decrypted())
+			continue
+		}
+		envs = append(envs, e.encrypted())
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"envs": envs,
+	})
+}
+
+func (s *Server) getEnvironmentVariable(w http.ResponseWriter, r *http.Request, params []string) {
+	p := s.findProject(r, params[0])
+	if p == nil {
+		writeNotFound(w, "Project")
+		return
+	}
+	e := p.findEnv(params[1])
+	if e == nil {
+		writeNotFound(w, "Environment Variable")
+		return
+	}
+	writeJSON(w, http.StatusOK, e.decrypted())
+}
+
+func (s *Server) createEnvironmentVariable(w http.ResponseWriter, r *http.Request, params []string) {
+	p := s.findProject(r, params[0])
+	if p == nil {
+		writeNotFound(w, "Project")
+		return
+	}
+	var e env
+	if !decode(w, r, &e) {
+		return
+	}
+	if err := p.addEnv(&e); err != nil {
+		writeError(w, http.StatusBadRequest, "ENV_CONFLICT", err.Error())
+		return
+	}
+	writeJSON(w, http.StatusCreated, e.encrypted())
+}
+
+func (s *Server) createEnvironmentVariables(w http.ResponseWriter, r *http.Request, params []string) {
+	p := s.findProject(r, params[0])
+	if p == nil {
+		writeNotFound(w, "Project")
+		return
+	}
+	var envs []env
+	if !decode(w, r, &envs) {
+		return
+	}
+	created := []env{}
+	for _, e := range envs {
+		e := e
+		if err := p.addEnv(&e); err != nil {
+			writeError(w, http.StatusBadRequest, "ENV_CONFLICT", err.Error())
+			return
+		}
+		created = append(created, e.encrypted())
+	}
+	writeJSON(w, http.StatusCreated, map[string]interface{}{
+		"created": created,
+	})
+}
+
+func (s *Server) updateEnvironmentVariable(w http.ResponseWriter, r *http.Request, params []string) {
+	p := s.findProject(r, params[0])
+	if p == nil {
+		writeNotFound(w, "Project")
+		return
+	}
+	e := p.findEnv(params[1])
+	if e == nil {
+		writeNotFound(w, "Environment Variable")
+		return
+	}
+	var req map[string]json.RawMessage
+	if !decode(w, r, &req) {
+		return
+	}
+	updated := *e
+	// The gitBranch is only retained if it is explicitly passed.
+	updated.GitBranch = nil
+	for key, raw := range req {
+		var err error
+		switch key {
+		case "key":
+			err = json.Unmarshal(raw, &updated.Key)
+		case "value":
+			err = json.Unmarshal(raw, &updated.Value)
+		case "target":
+			err = json.Unmarshal(raw, &updated.Target)
+		case "gitBranch":
+			err = json.Unmarshal(raw, &updated.GitBranch)
+		case "type":
+			err = json.Unmarshal(raw, &updated.Type)
+		case "comment":
+			err = json.Unmarshal(raw, &updated.Comment)
+		case "customEnvironmentIds":
+			if string(raw) == "null" {
+				err = fmt.Errorf("should be array")
+				break
+			}
+			err = json.Unmarshal(raw, &updated.CustomEnvironmentIDs)
+		default:
+			err = fmt.Errorf("unknown property")
+		}
+		if err != nil {
+			writeValidationError(w, key, fmt.Sprintf("Invalid request: `%s` is invalid: %s", key, err))
+			return
+		}
+	}
+	if updated.CustomEnvironmentIDs == nil {
+		updated.CustomEnvironmentIDs = []string{}
+	}
+	if err := p.validateEnv(&updated, e.ID); err != nil {
+		writeError(w, http.StatusBadRequest, "ENV_CONFLICT", err.Error())
+		return
+	}
+	*e = updated
+	writeJSON(w, http.StatusOK, e.encrypted())
+}
+
+func (s *Server) deleteEnvironmentVariable(w http.ResponseWriter, r *http.Request, params []string) {
+	p := s.findProject(r, params[0])
+	if p == nil {
+		writeNotFound(w, "Project")
+		return
+	}
+	for i, e := range p.envs {
+		if e.ID == params[1] {
+			p.envs = append(p.envs[:i], p.envs[i+1:]...)
+			writeJSON(w, http.StatusOK, e.encrypted())
+			return
+		}
+	}
+	writeNotFound(w, "Environment Variable")
+}
+
+type sharedEnv struct {
+	ID         string   `json:"id"`
+	Key        string   `json:"key"`
+	Value      string   `json:"value"`
+	Type       string   `json:"type"`
+	Comment    string   `json:"comment,omitempty"`
+	Target     []string `json:"target"`
+	ProjectIDs []string `json:"projectId"`
+	OwnerID    string   `json:"ownerId"`
+}
+
+// encrypted returns a copy of the shared environment variable as Vercel returns it when values
+// have not been explicitly decrypted.
+func (e *sharedEnv) encrypted() sharedEnv {
+	c := *e
+	if e.Type != "plain" {
+		c.Value = fmt.Sprintf("encrypted:%x", e.Value)
+	}
+	return c
+}
+
+// decrypted returns a copy of the shared environment variable as Vercel returns it when values
+// have been decrypted. Sensitive environment variables can never be decrypted.
+func (e *sharedEnv) decrypted() sharedEnv {
+	c := *e
+	if e.Type == "sensitive" {
+		c.Value = ""
+	}
+	return c
+}
+
+func (s *Server) createSharedEnvironmentVariable(w http.ResponseWriter, r *http.Request, _ []string) {
+	if teamID(r) == "" {
+		writeError(w, http.StatusBadRequest, "bad_request", "Shared environment variables can only be created for teams")
+		return
+	}
+	var req struct {
+		Type       string   `json:"type"`
+		ProjectIDs []string `json:"projectId"`
+		Target     []string `json:"target"`
+		EVs        []struct {
+			Key     string `json:"key"`
+			Value   string `json:"value"`
+			Comment string `json:"comment"`
+		} `json:"evs"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	if !validEnvType(req.Type) {
+		writeValidationError(w, "type", fmt.Sprintf("Invalid request: `type` should be one of plain, encrypted, sensitive, but got %s", req.Type))
+		return
+	}
+	created := []sharedEnv{}
+	for _, ev := range req.EVs {
+		for _, existing := range s.sharedEnvs {
+			if existing.OwnerID == teamID(r) && existing.Key == ev.Key && overlaps(existing.Target, req.Target) {
+				writeError(w, http.StatusBadRequest, "ENV_CONFLICT", fmt.Sprintf("A shared variable with the name `%s` already exists", ev.Key))
+				return
+			}
+		}
+		e := &sharedEnv{
+			ID:         newID("env_"),
+			Key:        ev.Key,
+			Value:      ev.Value,
+			Type:       req.Type,
+			Comment:    ev.Comment,
+			Target:     req.Target,
+			ProjectIDs: req.ProjectIDs,
+			OwnerID:    teamID(r),
+		}
+		s.sharedEnvs[e.ID] = e
+		c := e.encrypted()
+		created = append(created, c)
+	}
+	writeJSON(w, http.StatusCreated, map[string]interface{}{
+		"created": created,
+		"failed":  []interface{}{},
+	})
+}
+
+func (s *Server) findSharedEnv(r *http.Request, id string) *sharedEnv {
+	e, ok := s.sharedEnvs[id]
+	if !ok || e.OwnerID != teamID(r) {
+		return nil
+	}
+	return e
+}
+
+func (s *Server) getSharedEnvironmentVariable(w http.ResponseWriter, r *http.Request, params []string) {
+	e := s.findSharedEnv(r, params[0])
+	if e == nil {
+		writeNotFound(w, "Shared Environment Variable")
+		return
+	}
+	writeJSON(w, http.StatusOK, e.decrypted())
+}
+
+func (s *Server) updateSharedEnvironmentVariable(w http.ResponseWriter, r *http.Request, _ []string) {
+	var req struct {
+		Updates map[string]struct {
+			Key        string   `json:"key"`
+			Value      string   `json:"value"`
+			Type       string   `json:"type"`
+			Comment    string   `json:"comment"`
+			ProjectIDs []string `json:"projectId"`
+			Target     []string `json:"target"`
+		} `json:"updates"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	updated := []sharedEnv{}
+	for id, u := range req.Updates {
+		e := s.findSharedEnv(r, id)
+		if e == nil {
+			writeNotFound(w, "Shared Environment Variable")
+			return
+		}
+		e.Key, e.Value, e.Type, e.Comment, e.ProjectIDs, e.Target = u.Key, u.Value, u.Type, u.Comment, u.ProjectIDs, u.Target
+		c := e.encrypted()
+		updated = append(updated, c)
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"updated": updated,
+		"failed":  []interface{}{},
+	})
+}
+
+func (s *Server) deleteSharedEnvironmentVariable(w http.ResponseWriter, r *http.Request, _ []string) {
+	var req struct {
+		IDs []string `json:"ids"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	for _, id := range req.IDs {
+		if s.findSharedEnv(r, id) == nil {
+			writeNotFound(w, "Shared Environment Variable")
+			return
+		}
+	}
+	for _, id := range req.IDs {
+		delete(s.sharedEnvs, id)
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"deleted": req.IDs,
+	})
+}
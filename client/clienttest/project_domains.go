@@ -0,0 +1,222 @@
+package clienttest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type projectDomain struct {
+	Name                string  `json:"name"`
+	ProjectID           string  `json:"projectId"`
+	Redirect            *string `json:"redirect"`
+	RedirectStatusCode  *int64  `json:"redirectStatusCode"`
+	GitBranch           *string `json:"gitBranch"`
+	CustomEnvironmentID *string `json:"customEnvironmentId"`
+	Verified            bool    `json:"verified"`
+	// Verification is only included in responses while the domain is not verified.
+	Verification []domainVerification `json:"verification,omitempty"`
+}
+
+type domainVerification struct {
+	Type   string `json:"type"`
+	Domain string `json:"domain"`
+	Value  string `json:"value"`
+	Reason string `json:"reason"`
+}
+
+// verificationFor returns the challenge that must be completed to use a domain within a team. A
+// domain, or a subdomain of one, that has been added to another account must be verified with a
+// TXT record.
+func (s *Server) verificationFor(r *http.Request, name string) []domainVerification {
+	for apex, d := range s.domains {
+		if (name == apex || strings.HasSuffix(name, "."+apex)) && d.teamID != teamID(r) {
+			return []domainVerification{{
+				Type:   "TXT",
+				Domain: "_vercel." + apex,
+				Value:  d.VerificationRecord,
+				Reason: "pending_domain_verification",
+			}}
+		}
+	}
+	return nil
+}
+
+// verificationComplete reports whether the TXT records needed to complete a verification exist.
+func (s *Server) verificationComplete(verification []domainVerification) bool {
+	for _, v := range verification {
+		found := false
+		for _, record := range s.dnsRecords {
+			if record.RecordType == v.Type && record.Name+"."+record.Domain == v.Domain && record.Value == v.Value {
+				found = true
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *project) findDomain(name string) *projectDomain {
+	for _, d := range p.domains {
+		if d.Name == name {
+			return d
+		}
+	}
+	return nil
+}
+
+func (s *Server) domainInUse(name string) bool {
+	for _, p := range s.projects {
+		if p.findDomain(name) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) createProjectDomain(w http.ResponseWriter, r *http.Request, params []string) {
+	p := s.findProject(r, params[0])
+	if p == nil {
+		writeNotFound(w, "Project")
+		return
+	}
+	var req struct {
+		Name                string `json:"name"`
+		GitBranch           string `json:"gitBranch"`
+		Redirect            string `json:"redirect"`
+		RedirectStatusCode  int64  `json:"redirectStatusCode"`
+		CustomEnvironmentID string `json:"customEnvironmentId"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	if c := p.findCustomEnvironment(req.CustomEnvironmentID); req.CustomEnvironmentID != "" && (c == nil || c.ID != req.CustomEnvironmentID) {
+		writeNotFound(w, "Custom Environment")
+		return
+	}
+	if req.Name == "" {
+		writeError(w, http.StatusBadRequest, "bad_request", "Domain name is required")
+		return
+	}
+	if s.domainInUse(req.Name) {
+		writeError(w, http.StatusConflict, "domain_already_in_use", fmt.Sprintf("The domain %s is already in use by a project", req.Name))
+		return
+	}
+	d := &projectDomain{
+		Name:         req.Name,
+		ProjectID:    p.ID,
+		Verification: s.verificationFor(r, req.Name),
+	}
+	d.Verified = len(d.Verification) == 0
+	if req.GitBranch != "" {
+		d.GitBranch = &req.GitBranch
+	}
+	if req.Redirect != "" {
+		d.Redirect = &req.Redirect
+	}
+	if req.RedirectStatusCode != 0 {
+		d.RedirectStatusCode = &req.RedirectStatusCode
+	}
+	if req.CustomEnvironmentID != "" {
+		d.CustomEnvironmentID = &req.CustomEnvironmentID
+	}
+	p.domains = append(p.domains, d)
+	writeJSON(w, http.StatusOK, d)
+}
+
+func (s *Server) getProjectDomain(w http.ResponseWriter, r *http.Request, params []string) {
+	p := s.findProject(r, params[0])
+	if p == nil {
+		writeNotFound(w, "Project")
+		return
+	}
+	d := p.findDomain(params[1])
+	if d == nil {
+		writeNotFound(w, "Domain")
+		return
+	}
+	writeJSON(w, http.StatusOK, d)
+}
+
+func (s *Server) updateProjectDomain(w http.ResponseWriter, r *http.Request, params []string) {
+	p := s.findProject(r, params[0])
+	if p == nil {
+		writeNotFound(w, "Project")
+		return
+	}
+	d := p.findDomain(params[1])
+	if d == nil {
+		writeNotFound(w, "Domain")
+		return
+	}
+	var req map[string]json.RawMessage
+	if !decode(w, r, &req) {
+		return
+	}
+	updated := *d
+	for key, raw := range req {
+		var err error
+		switch key {
+		case "gitBranch":
+			updated.GitBranch, err = nullableString(raw)
+		case "redirect":
+			updated.Redirect, err = nullableString(raw)
+		case "redirectStatusCode":
+			err = json.Unmarshal(raw, &updated.RedirectStatusCode)
+		case "customEnvironmentId":
+			updated.CustomEnvironmentID, err = nullableString(raw)
+			if id := updated.CustomEnvironmentID; err == nil && id != nil {
+				if c := p.findCustomEnvironment(*id); c == nil || c.ID != *id {
+					err = fmt.Errorf("custom environment %s not found", *id)
+				}
+			}
+		default:
+			err = fmt.Errorf("unknown property")
+		}
+		if err != nil {
+			writeValidationError(w, key, fmt.Sprintf("Invalid request: `%s` is invalid: %s", key, err))
+			return
+		}
+	}
+	*d = updated
+	writeJSON(w, http.StatusOK, d)
+}
+
+func (s *Server) deleteProjectDomain(w http.ResponseWriter, r *http.Request, params []string) {
+	p := s.findProject(r, params[0])
+	if p == nil {
+		writeNotFound(w, "Project")
+		return
+	}
+	for i, d := range p.domains {
+		if d.Name == params[1] {
+			p.domains = append(p.domains[:i], p.domains[i+1:]...)
+			writeJSON(w, http.StatusOK, map[string]interface{}{})
+			return
+		}
+	}
+	writeNotFound(w, "Domain")
+}
+
+func (s *Server) verifyProjectDomain(w http.ResponseWriter, r *http.Request, params []string) {
+	p := s.findProject(r, params[0])
+	if p == nil {
+		writeNotFound(w, "Project")
+		return
+	}
+	d := p.findDomain(params[1])
+	if d == nil {
+		writeNotFound(w, "Domain")
+		return
+	}
+	if !d.Verified && !s.verificationComplete(d.Verification) {
+		writeError(w, http.StatusBadRequest, "missing_txt_record", fmt.Sprintf("Domain %s is missing required TXT Record", d.Name))
+		return
+	}
+	d.Verified = true
+	d.Verification = nil
+	writeJSON(w, http.StatusOK, d)
+}
@@ -0,0 +1,392 @@
+package clienttest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+type protection struct {
+	DeploymentType string `json:"deploymentType"`
+}
+
+type protectionBypass struct {
+	Scope string `json:"scope"`
+}
+
+type link struct {
+	Type string `json:"type"`
+	// github
+	Org  string `json:"org,omitempty"`
+	Repo string `json:"repo,omitempty"`
+	// bitbucket
+	Owner string `json:"owner,omitempty"`
+	Slug  string `json:"slug,omitempty"`
+	// gitlab
+	ProjectNamespace string `json:"projectNamespace,omitempty"`
+	ProjectURL       string `json:"projectUrl,omitempty"`
+	ProjectID        string `json:"projectId,omitempty"`
+
+	ProductionBranch *string `json:"productionBranch"`
+}
+
+type resourceConfig struct {
+	BuildMachineType          string `json:"buildMachineType"`
+	FunctionDefaultMemoryType string `json:"functionDefaultMemoryType"`
+	FunctionDefaultTimeout    int64  `json:"functionDefaultTimeout"`
+}
+
+// maxSkewProtectionMaxAge is the longest time, in seconds, that Vercel keeps previous
+// deployments available for skew protection.
+const maxSkewProtectionMaxAge = 60 * 60 * 24 * 7
+
+func oneOf(value string, items ...string) error {
+	for _, i := range items {
+		if value == i {
+			return nil
+		}
+	}
+	return fmt.Errorf("should be one of %s", strings.Join(items, ", "))
+}
+
+type project struct {
+	AutoExposeSystemEnvs            bool                        `json:"autoExposeSystemEnvs"`
+	BuildCommand                    *string                     `json:"buildCommand"`
+	CommandForIgnoringBuildStep     *string                     `json:"commandForIgnoringBuildStep"`
+	DevCommand                      *string                     `json:"devCommand"`
+	Framework                       *string                     `json:"framework"`
+	ID                              string                      `json:"id"`
+	InstallCommand                  *string                     `json:"installCommand"`
+	Link                            *link                       `json:"link,omitempty"`
+	Name                            string                      `json:"name"`
+	NodeVersion                     string                      `json:"nodeVersion"`
+	OutputDirectory                 *string                     `json:"outputDirectory"`
+	PublicSource                    *bool                       `json:"publicSource"`
+	ResourceConfig                  resourceConfig              `json:"resourceConfig"`
+	RootDirectory                   *string                     `json:"rootDirectory"`
+	ServerlessFunctionRegion        *string                     `json:"serverlessFunctionRegion"`
+	SkewProtectionMaxAge            int64                       `json:"skewProtectionMaxAge,omitempty"`
+	SourceFilesOutsideRootDirectory bool                        `json:"sourceFilesOutsideRootDirectory"`
+	SSOProtection                   *protection                 `json:"ssoProtection"`
+	PasswordProtection              *protection                 `json:"passwordProtection"`
+	ProtectionBypass                map[string]protectionBypass `json:"protectionBypass"`
+	CreatedAt                       int64                       `json:"createdAt"`
+	UpdatedAt                       int64                       `json:"updatedAt"`
+
+	teamID             string
+	envs               []*env
+	domains            []*projectDomain
+	customEnvironments []*customEnvironment
+}
+
+func newLink(repoType, repo string) (*link, error) {
+	parts := strings.SplitN(repo, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("repository %q should be in the format owner/name", repo)
+	}
+	branch := "main"
+	l := &link{
+		Type:             repoType,
+		ProductionBranch: &branch,
+	}
+	switch repoType {
+	case "github":
+		l.Org, l.Repo = parts[0], parts[1]
+	case "gitlab":
+		l.ProjectNamespace = parts[0]
+		l.ProjectURL = fmt.Sprintf("https://gitlab.com/%s.git", repo)
+		l.ProjectID = fmt.Sprint(len(repo) * 1000)
+	case "bitbucket":
+		l.Owner, l.Slug = parts[0], parts[1]
+	default:
+		return nil, fmt.Errorf("unsupported git provider %q", repoType)
+	}
+	return l, nil
+}
+
+// findProject looks up a project by ID or name, scoped to the team of the request.
+func (s *Server) findProject(r *http.Request, idOrName string) *project {
+	for _, p := range s.projects {
+		if p.teamID == teamID(r) && (p.ID == idOrName || p.Name == idOrName) {
+			return p
+		}
+	}
+	return nil
+}
+
+func (s *Server) listProjects(w http.ResponseWriter, r *http.Request, _ []string) {
+	search := r.URL.Query().Get("search")
+	projects := []*project{}
+	for _, p := range s.projects {
+		if p.teamID == teamID(r) && strings.Contains(p.Name, search) {
+			projects = append(projects, p)
+		}
+	}
+	// Projects are paginated by when they were last updated.
+	sort.Slice(projects, func(i, j int) bool {
+		return projects[i].UpdatedAt > projects[j].UpdatedAt
+	})
+	writePage(w, r, "projects", projects, func(p *project) int64 {
+		return p.UpdatedAt
+	})
+}
+
+func (s *Server) createProject(w http.ResponseWriter, r *http.Request, _ []string) {
+	var req struct {
+		BuildCommand                *string `json:"buildCommand"`
+		CommandForIgnoringBuildStep *string `json:"commandForIgnoringBuildStep"`
+		DevCommand                  *string `json:"devCommand"`
+		EnvironmentVariables        []env   `json:"environmentVariables"`
+		Framework                   *string `json:"framework"`
+		GitRepository               *struct {
+			Type string `json:"type"`
+			Repo string `json:"repo"`
+		} `json:"gitRepository"`
+		InstallCommand           *string `json:"installCommand"`
+		Name                     string  `json:"name"`
+		OutputDirectory          *string `json:"outputDirectory"`
+		PublicSource             *bool   `json:"publicSource"`
+		RootDirectory            *string `json:"rootDirectory"`
+		ServerlessFunctionRegion *string `json:"serverlessFunctionRegion"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.Name == "" {
+		writeValidationError(w, "name", "Invalid request: missing required property `name`")
+		return
+	}
+	if s.findProject(r, req.Name) != nil {
+		writeError(w, http.StatusConflict, "conflict", "A project with that name already exists")
+		return
+	}
+	p := &project{
+		BuildCommand:                    req.BuildCommand,
+		CommandForIgnoringBuildStep:     req.CommandForIgnoringBuildStep,
+		DevCommand:                      req.DevCommand,
+		Framework:                       req.Framework,
+		ID:                              newID("prj_"),
+		InstallCommand:                  req.InstallCommand,
+		Name:                            req.Name,
+		OutputDirectory:                 req.OutputDirectory,
+		PublicSource:                    req.PublicSource,
+		RootDirectory:                   req.RootDirectory,
+		ServerlessFunctionRegion:        req.ServerlessFunctionRegion,
+		SSOProtection:                   &protection{DeploymentType: "preview"},
+		ProtectionBypass:                map[string]protectionBypass{},
+		AutoExposeSystemEnvs:            true,
+		NodeVersion:                     "20.x",
+		SourceFilesOutsideRootDirectory: true,
+		ResourceConfig: resourceConfig{
+			BuildMachineType:          "standard",
+			FunctionDefaultMemoryType: "standard",
+			FunctionDefaultTimeout:    10,
+		},
+		teamID: teamID(r),
+	}
+	p.CreatedAt = s.now()
+	p.UpdatedAt = p.CreatedAt
+	if p.ServerlessFunctionRegion == nil {
+		region := "iad1"
+		p.ServerlessFunctionRegion = &region
+	}
+	if req.GitRepository != nil {
+		l, err := newLink(req.GitRepository.Type, req.GitRepository.Repo)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "bad_request", err.Error())
+			return
+		}
+		p.Link = l
+	}
+	for _, e := range req.EnvironmentVariables {
+		e := e
+		if err := p.addEnv(&e); err != nil {
+			writeError(w, http.StatusBadRequest, "ENV_CONFLICT", err.Error())
+			return
+		}
+	}
+	s.projects[p.ID] = p
+	writeJSON(w, http.StatusOK, p)
+}
+
+func (s *Server) getProject(w http.ResponseWriter, r *http.Request, params []string) {
+	p := s.findProject(r, params[0])
+	if p == nil {
+		writeNotFound(w, "Project")
+		return
+	}
+	writeJSON(w, http.StatusOK, p)
+}
+
+// nullableString converts an update to a string field into its stored value. Vercel treats
+// both null and an empty string as removing the setting.
+func nullableString(raw json.RawMessage) (*string, error) {
+	var v *string
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return nil, err
+	}
+	if v != nil && *v == "" {
+		return nil, nil
+	}
+	return v, nil
+}
+
+func (s *Server) updateProject(w http.ResponseWriter, r *http.Request, params []string) {
+	p := s.findProject(r, params[0])
+	if p == nil {
+		writeNotFound(w, "Project")
+		return
+	}
+	var req map[string]json.RawMessage
+	if !decode(w, r, &req) {
+		return
+	}
+
+	updated := *p
+	stringFields := map[string]**string{
+		"buildCommand":                &updated.BuildCommand,
+		"commandForIgnoringBuildStep": &updated.CommandForIgnoringBuildStep,
+		"devCommand":                  &updated.DevCommand,
+		"framework":                   &updated.Framework,
+		"installCommand":              &updated.InstallCommand,
+		"outputDirectory":             &updated.OutputDirectory,
+		"rootDirectory":               &updated.RootDirectory,
+		"serverlessFunctionRegion":    &updated.ServerlessFunctionRegion,
+	}
+	for key, raw := range req {
+		var err error
+		switch key {
+		case "name":
+			var name string
+			err = json.Unmarshal(raw, &name)
+			if err == nil && name != p.Name && s.findProject(r, name) != nil {
+				writeError(w, http.StatusConflict, "conflict", "A project with that name already exists")
+				return
+			}
+			updated.Name = name
+		case "publicSource":
+			err = json.Unmarshal(raw, &updated.PublicSource)
+		case "autoExposeSystemEnvs":
+			err = json.Unmarshal(raw, &updated.AutoExposeSystemEnvs)
+		case "sourceFilesOutsideRootDirectory":
+			err = json.Unmarshal(raw, &updated.SourceFilesOutsideRootDirectory)
+		case "nodeVersion":
+			err = json.Unmarshal(raw, &updated.NodeVersion)
+			if err == nil {
+				err = oneOf(updated.NodeVersion, "22.x", "20.x", "18.x")
+			}
+		case "skewProtectionMaxAge":
+			err = json.Unmarshal(raw, &updated.SkewProtectionMaxAge)
+			if err == nil && (updated.SkewProtectionMaxAge < 0 || updated.SkewProtectionMaxAge > maxSkewProtectionMaxAge) {
+				err = fmt.Errorf("should be between 0 and %d", maxSkewProtectionMaxAge)
+			}
+		case "resourceConfig":
+			var rc struct {
+				BuildMachineType          *string `json:"buildMachineType"`
+				FunctionDefaultMemoryType *string `json:"functionDefaultMemoryType"`
+				FunctionDefaultTimeout    *int64  `json:"functionDefaultTimeout"`
+			}
+			err = json.Unmarshal(raw, &rc)
+			if err == nil && rc.BuildMachineType != nil {
+				updated.ResourceConfig.BuildMachineType = *rc.BuildMachineType
+				err = oneOf(*rc.BuildMachineType, "standard", "enhanced", "turbo")
+			}
+			if err == nil && rc.FunctionDefaultMemoryType != nil {
+				updated.ResourceConfig.FunctionDefaultMemoryType = *rc.FunctionDefaultMemoryType
+				err = oneOf(*rc.FunctionDefaultMemoryType, "standard_legacy", "standard", "performance")
+			}
+			if err == nil && rc.FunctionDefaultTimeout != nil {
+				updated.ResourceConfig.FunctionDefaultTimeout = *rc.FunctionDefaultTimeout
+				if *rc.FunctionDefaultTimeout < 1 || *rc.FunctionDefaultTimeout > 900 {
+					err = fmt.Errorf("functionDefaultTimeout should be between 1 and 900")
+				}
+			}
+		case "ssoProtection":
+			err = json.Unmarshal(raw, &updated.SSOProtection)
+		case "passwordProtection":
+			var pp *struct {
+				DeploymentType string `json:"deploymentType"`
+				Password       string `json:"password"`
+			}
+			err = json.Unmarshal(raw, &pp)
+			updated.PasswordProtection = nil
+			if pp != nil {
+				updated.PasswordProtection = &protection{DeploymentType: pp.DeploymentType}
+			}
+		default:
+			field, ok := stringFields[key]
+			if !ok {
+				writeValidationError(w, key, fmt.Sprintf("Invalid request: should NOT have additional property `%s`", key))
+				return
+			}
+			*field, err = nullableString(raw)
+		}
+		if err != nil {
+			writeValidationError(w, key, fmt.Sprintf("Invalid request: `%s` is invalid: %s", key, err))
+			return
+		}
+	}
+	updated.UpdatedAt = s.now()
+	*p = updated
+	writeJSON(w, http.StatusOK, p)
+}
+
+func (s *Server) deleteProject(w http.ResponseWriter, r *http.Request, params []string) {
+	p := s.findProject(r, params[0])
+	if p == nil {
+		writeNotFound(w, "Project")
+		return
+	}
+	delete(s.projects, p.ID)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) updateProductionBranch(w http.ResponseWriter, r *http.Request, params []string) {
+	p := s.findProject(r, params[0])
+	if p == nil {
+		writeNotFound(w, "Project")
+		return
+	}
+	var req struct {
+		Branch string `json:"branch"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	if p.Link == nil {
+		writeError(w, http.StatusBadRequest, "bad_request", "The project is not connected to a git repository")
+		return
+	}
+	p.Link.ProductionBranch = &req.Branch
+	writeJSON(w, http.StatusOK, p)
+}
+
+func (s *Server) updateProtectionBypass(w http.ResponseWriter, r *http.Request, params []string) {
+	p := s.findProject(r, params[0])
+	if p == nil {
+		writeNotFound(w, "Project")
+		return
+	}
+	var req struct {
+		Revoke *struct {
+			Secret string `json:"secret"`
+		} `json:"revoke"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.Revoke != nil {
+		if _, ok := p.ProtectionBypass[req.Revoke.Secret]; !ok {
+			writeNotFound(w, "Protection bypass")
+			return
+		}
+		delete(p.ProtectionBypass, req.Revoke.Secret)
+	} else {
+		p.ProtectionBypass[randomString(32)] = protectionBypass{Scope: "automation-bypass"}
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"protectionBypass": p.ProtectionBypass,
+	})
+}
@@ -0,0 +1,423 @@
+// Package clienttest provides an in-memory fake of the subset of the Vercel API
+// used by the client package. It allows the client, and the provider built on top
+// of it, to be exercised end-to-end without any network access.
+package clienttest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+// Fault describes a failure that the fake server should return instead of
+// handling a request normally.
+type Fault struct {
+	// Method restricts the fault to a HTTP method. An empty Method matches any method.
+	Method string
+	// Path restricts the fault to requests whose path starts with Path. An empty Path matches any path.
+	Path string
+	// StatusCode is the HTTP status code to respond with.
+	StatusCode int
+	// Code and Message populate the standard Vercel error body.
+	Code    string
+	Message string
+	// Header contains any additional response headers, e.g. Retry-After.
+	Header http.Header
+	// Disconnect closes the connection without writing a response, simulating a network failure.
+	Disconnect bool
+	// Times is the number of requests the fault applies to. Zero means a single request.
+	Times int
+}
+
+func (f *Fault) matches(r *http.Request) bool {
+	if f.Method != "" && f.Method != r.Method {
+		return false
+	}
+	return strings.HasPrefix(r.URL.Path, f.Path)
+}
+
+// DeploymentBehaviour controls how deployments created against the fake server progress.
+type DeploymentBehaviour struct {
+	// PendingPolls is the number of times a deployment reports itself as BUILDING
+	// before it settles into its final state.
+	PendingPolls int
+	// ReadyState is the state a deployment settles into. Defaults to READY.
+	ReadyState string
+	// ErrorCode and ErrorMessage are reported by deployments that settle into the ERROR state.
+	ErrorCode    string
+	ErrorMessage string
+	// BuildLogs are the lines of output reported by the deployment's build. Deployments
+	// that do not configure any report a short, generic build log.
+	BuildLogs []string
+}
+
+// Request is a record of a request that was received by the fake server.
+type Request struct {
+	Method string
+	Path   string
+	Query  string
+	Header http.Header
+	Body   []byte
+}
+
+type handlerFunc func(w http.ResponseWriter, r *http.Request, params []string)
+
+type route struct {
+	method  string
+	pattern *regexp.Regexp
+	handler handlerFunc
+}
+
+// Server is a fake implementation of the Vercel API, backed by in-memory state.
+type Server struct {
+	// URL is the base URL of the fake server, suitable for client.WithBaseURL.
+	URL string
+	// Token is the API token the fake server accepts.
+	Token string
+
+	server *httptest.Server
+	routes []route
+
+	mu                  sync.Mutex
+	faults              []*Fault
+	requests            []Request
+	deploymentBehaviour DeploymentBehaviour
+	teams               map[string]*team
+	projects            map[string]*project
+	sharedEnvs          map[string]*sharedEnv
+	deployments         map[string]*deployment
+	files               map[string]int
+	aliases             map[string]*alias
+	dnsRecords          map[string]*dnsRecord
+	domains             map[string]*domain
+	domainConfigs       map[string]string
+	// clock is the time, in milliseconds, most recently given to an entity. Each entity is given a
+	// distinct time, so that pagination, which is based upon them, is deterministic.
+	clock int64
+}
+
+// NewServer starts a new fake Vercel API server. Callers should call Close once finished.
+func NewServer() *Server {
+	s := &Server{
+		Token:         randomString(24),
+		teams:         map[string]*team{},
+		projects:      map[string]*project{},
+		sharedEnvs:    map[string]*sharedEnv{},
+		deployments:   map[string]*deployment{},
+		files:         map[string]int{},
+		aliases:       map[string]*alias{},
+		dnsRecords:    map[string]*dnsRecord{},
+		domains:       map[string]*domain{},
+		domainConfigs: map[string]string{},
+		clock:         time.Now().UnixMilli(),
+	}
+	s.registerRoutes()
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.server.URL
+	return s
+}
+
+// Close shuts down the fake server.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Client returns a client.Client configured to talk to the fake server. Any options
+// are applied after the fake server's base URL.
+func (s *Server) Client(opts ...client.Option) *client.Client {
+	return client.New(s.Token, append([]client.Option{client.WithBaseURL(s.URL)}, opts...)...)
+}
+
+// AddFault registers a failure that will be returned for matching requests.
+// Faults are matched in the order they are added.
+func (s *Server) AddFault(f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if f.Times <= 0 {
+		f.Times = 1
+	}
+	s.faults = append(s.faults, &f)
+}
+
+// SetDeploymentBehaviour controls how any subsequently created deployments progress.
+func (s *Server) SetDeploymentBehaviour(b DeploymentBehaviour) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.deploymentBehaviour = b
+}
+
+// Requests returns every request the fake server has received so far.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request{}, s.requests...)
+}
+
+// RequestCount returns the number of requests received with the given method and path prefix.
+func (s *Server) RequestCount(method, pathPrefix string) int {
+	count := 0
+	for _, r := range s.Requests() {
+		if (method == "" || r.Method == method) && strings.HasPrefix(r.Path, pathPrefix) {
+			count++
+		}
+	}
+	return count
+}
+
+func (s *Server) handle(method, pattern string, h handlerFunc) {
+	s.routes = append(s.routes, route{
+		method:  method,
+		pattern: regexp.MustCompile("^" + pattern + "$"),
+		handler: h,
+	})
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", "unable to read request body")
+		return
+	}
+	r.Body = io.NopCloser(strings.NewReader(string(body)))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = append(s.requests, Request{
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Query:  r.URL.RawQuery,
+		Header: r.Header.Clone(),
+		Body:   body,
+	})
+
+	if r.Header.Get("Authorization") != "Bearer "+s.Token {
+		writeError(w, http.StatusForbidden, "forbidden", "Not authorized")
+		return
+	}
+
+	for i, f := range s.faults {
+		if !f.matches(r) {
+			continue
+		}
+		f.Times--
+		if f.Times <= 0 {
+			s.faults = append(s.faults[:i], s.faults[i+1:]...)
+		}
+		if f.Disconnect {
+			disconnect(w)
+			return
+		}
+		for k, v := range f.Header {
+			w.Header()[k] = v
+		}
+		writeError(w, f.StatusCode, f.Code, f.Message)
+		return
+	}
+
+	if teamID := r.URL.Query().Get("teamId"); teamID != "" && s.teams[teamID] == nil {
+		writeError(w, http.StatusForbidden, "forbidden", "You don't have permission to access this team")
+		return
+	}
+
+	for _, rt := range s.routes {
+		if rt.method != r.Method {
+			continue
+		}
+		m := rt.pattern.FindStringSubmatch(r.URL.Path)
+		if m == nil {
+			continue
+		}
+		rt.handler(w, r, m[1:])
+		return
+	}
+	writeError(w, http.StatusNotFound, "not_found", fmt.Sprintf("The requested path %s %s does not exist", r.Method, r.URL.Path))
+}
+
+func (s *Server) registerRoutes() {
+	s.handle("POST", `/v1/teams`, s.createTeam)
+	s.handle("GET", `/v2/teams/([^/]+)`, s.getTeam)
+	s.handle("PATCH", `/v2/teams/([^/]+)`, s.updateTeam)
+	s.handle("DELETE", `/v1/teams/([^/]+)`, s.deleteTeam)
+	s.handle("GET", `/v2/teams/([^/]+)/members`, s.listTeamMembers)
+	s.handle("POST", `/v1/teams/([^/]+)/members`, s.createTeamMember)
+	s.handle("PATCH", `/v1/teams/([^/]+)/members/([^/]+)`, s.updateTeamMember)
+	s.handle("DELETE", `/v1/teams/([^/]+)/members/([^/]+)`, s.deleteTeamMember)
+
+	s.handle("GET", `/v8/projects`, s.listProjects)
+	s.handle("POST", `/v8/projects`, s.createProject)
+	s.handle("GET", `/v10/projects/([^/]+)`, s.getProject)
+	s.handle("PATCH", `/v9/projects/([^/]+)`, s.updateProject)
+	s.handle("DELETE", `/v8/projects/([^/]+)`, s.deleteProject)
+	s.handle("PATCH", `/v9/projects/([^/]+)/branch`, s.updateProductionBranch)
+	s.handle("PATCH", `/v10/projects/([^/]+)/protection-bypass`, s.updateProtectionBypass)
+
+	s.handle("GET", `/v8/projects/([^/]+)/env`, s.listEnvironmentVariables)
+	s.handle("GET", `/v1/projects/([^/]+)/env/([^/]+)`, s.getEnvironmentVariable)
+	s.handle("POST", `/v9/projects/([^/]+)/env`, s.createEnvironmentVariable)
+	s.handle("POST", `/v10/projects/([^/]+)/env`, s.createEnvironmentVariables)
+	s.handle("PATCH", `/v9/projects/([^/]+)/env/([^/]+)`, s.updateEnvironmentVariable)
+	s.handle("DELETE", `/v8/projects/([^/]+)/env/([^/]+)`, s.deleteEnvironmentVariable)
+
+	s.handle("POST", `/v1/env`, s.createSharedEnvironmentVariable)
+	s.handle("GET", `/v1/env/([^/]+)`, s.getSharedEnvironmentVariable)
+	s.handle("PATCH", `/v1/env`, s.updateSharedEnvironmentVariable)
+	s.handle("DELETE", `/v1/env`, s.deleteSharedEnvironmentVariable)
+
+	s.handle("POST", `/v9/projects/([^/]+)/custom-environments`, s.createCustomEnvironment)
+	s.handle("GET", `/v9/projects/([^/]+)/custom-environments/([^/]+)`, s.getCustomEnvironment)
+	s.handle("PATCH", `/v9/projects/([^/]+)/custom-environments/([^/]+)`, s.updateCustomEnvironment)
+	s.handle("DELETE", `/v9/projects/([^/]+)/custom-environments/([^/]+)`, s.deleteCustomEnvironment)
+
+	s.handle("POST", `/v10/projects/([^/]+)/domains`, s.createProjectDomain)
+	s.handle("GET", `/v8/projects/([^/]+)/domains/([^/]+)`, s.getProjectDomain)
+	s.handle("PATCH", `/v8/projects/([^/]+)/domains/([^/]+)`, s.updateProjectDomain)
+	s.handle("DELETE", `/v8/projects/([^/]+)/domains/([^/]+)`, s.deleteProjectDomain)
+	s.handle("POST", `/v9/projects/([^/]+)/domains/([^/]+)/verify`, s.verifyProjectDomain)
+
+	s.handle("POST", `/v2/now/files`, s.createFile)
+	s.handle("POST", `/v12/now/deployments`, s.createDeployment)
+	s.handle("GET", `/v6/deployments`, s.listDeployments)
+	s.handle("GET", `/v13/deployments/([^/]+)`, s.getDeployment)
+	s.handle("DELETE", `/v13/deployments/([^/]+)`, s.deleteDeployment)
+	s.handle("PATCH", `/v12/deployments/([^/]+)/cancel`, s.cancelDeployment)
+	s.handle("GET", `/v3/deployments/([^/]+)/events`, s.getDeploymentEvents)
+
+	s.handle("POST", `/v2/deployments/([^/]+)/aliases`, s.createAlias)
+	s.handle("GET", `/v4/aliases`, s.listAliases)
+	s.handle("GET", `/v4/aliases/([^/]+)`, s.getAlias)
+	s.handle("DELETE", `/v2/aliases/([^/]+)`, s.deleteAlias)
+
+	s.handle("POST", `/v5/domains`, s.createDomain)
+	s.handle("GET", `/v5/domains/([^/]+)`, s.getDomain)
+	s.handle("PATCH", `/v3/domains/([^/]+)`, s.updateDomain)
+	s.handle("DELETE", `/v6/domains/([^/]+)`, s.deleteDomain)
+	s.handle("GET", `/v6/domains/([^/]+)/config`, s.getDomainConfig)
+
+	s.handle("POST", `/v4/domains/([^/]+)/records`, s.createDNSRecord)
+	s.handle("GET", `/v4/domains/([^/]+)/records`, s.listDNSRecords)
+	s.handle("GET", `/domains/records/([^/]+)`, s.getDNSRecord)
+	s.handle("PATCH", `/v4/domains/records/([^/]+)`, s.updateDNSRecord)
+	s.handle("DELETE", `/v2/domains/([^/]+)/records/([^/]+)`, s.deleteDNSRecord)
+}
+
+func teamID(r *http.Request) string {
+	return r.URL.Query().Get("teamId")
+}
+
+func disconnect(w http.ResponseWriter) {
+	hj, ok := w.(http.Hijacker)
+	if !ok {
+		panic("clienttest: response writer does not support hijacking")
+	}
+	conn, _, err := hj.Hijack()
+	if err != nil {
+		panic(err)
+	}
+	conn.Close()
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":    code,
+			"message": message,
+		},
+	})
+}
+
+// writeValidationError responds in the same way Vercel does when a request body does not match
+// the endpoint's schema, including the path to the invalid property.
+func writeValidationError(w http.ResponseWriter, field, message string) {
+	writeJSON(w, http.StatusBadRequest, map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":     "bad_request",
+			"message":  message,
+			"dataPath": "." + field,
+		},
+	})
+}
+
+// now returns the current time in milliseconds, as used for timestamps within the Vercel API.
+// Every call returns a later time than the last.
+func (s *Server) now() int64 {
+	s.clock++
+	return s.clock
+}
+
+// writePage responds with a single page of items, using the same cursor based pagination as Vercel.
+// The items must be sorted newest first, by the timestamp that pagination is based upon.
+func writePage[T any](w http.ResponseWriter, r *http.Request, field string, items []T, timestamp func(T) int64) {
+	query := r.URL.Query()
+	limit, err := strconv.Atoi(query.Get("limit"))
+	if err != nil || limit <= 0 {
+		limit = 20
+	}
+	if limit > 100 {
+		writeValidationError(w, "limit", "Invalid request: `limit` should be <= 100")
+		return
+	}
+	since, _ := strconv.ParseInt(query.Get("since"), 10, 64)
+	until, _ := strconv.ParseInt(query.Get("until"), 10, 64)
+
+	page := []T{}
+	var next interface{}
+	for _, item := range items {
+		ts := timestamp(item)
+		if (until != 0 && ts >= until) || (since != 0 && ts <= since) {
+			continue
+		}
+		if len(page) == limit {
+			next = timestamp(page[len(page)-1])
+			break
+		}
+		page = append(page, item)
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		field: page,
+		"pagination": map[string]interface{}{
+			"count": len(page),
+			"next":  next,
+			"prev":  nil,
+		},
+	})
+}
+
+func writeNotFound(w http.ResponseWriter, entity string) {
+	writeError(w, http.StatusNotFound, "not_found", fmt.Sprintf("%s not found", entity))
+}
+
+func decode(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	err := json.NewDecoder(r.Body).Decode(v)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", fmt.Sprintf("Invalid request body: %s", err))
+		return false
+	}
+	return true
+}
+
+func randomString(n int) string {
+	b := make([]byte, (n+1)/2)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)[:n]
+}
+
+func newID(prefix string) string {
+	return prefix + randomString(24)
+}
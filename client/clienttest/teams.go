@@ -0,0 +1,290 @@
+package clienttest
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+)
+
+type teamDeploymentProtection struct {
+	SSOProtection *protection `json:"ssoProtection"`
+}
+
+type team struct {
+	ID                          string                    `json:"id"`
+	Slug                        string                    `json:"slug"`
+	Name                        string                    `json:"name"`
+	Description                 *string                   `json:"description"`
+	Avatar                      *string                   `json:"avatar"`
+	DefaultDeploymentProtection *teamDeploymentProtection `json:"defaultDeploymentProtection"`
+
+	members []*teamMember
+}
+
+type teamMember struct {
+	UID       string `json:"uid"`
+	Username  string `json:"username"`
+	Email     string `json:"email"`
+	Role      string `json:"role"`
+	Confirmed bool   `json:"confirmed"`
+	CreatedAt int64  `json:"createdAt"`
+}
+
+var teamRoles = map[string]bool{
+	"OWNER":     true,
+	"MEMBER":    true,
+	"DEVELOPER": true,
+	"VIEWER":    true,
+	"BILLING":   true,
+}
+
+// AddTeam creates a team directly within the fake server's state, returning its ID.
+func (s *Server) AddTeam(slug, name string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t := &team{
+		ID:   newID("team_"),
+		Slug: slug,
+		Name: name,
+	}
+	s.teams[t.ID] = t
+	return t.ID
+}
+
+func (s *Server) findTeam(idOrSlug string) *team {
+	if t, ok := s.teams[idOrSlug]; ok {
+		return t
+	}
+	for _, t := range s.teams {
+		if t.Slug == idOrSlug {
+			return t
+		}
+	}
+	return nil
+}
+
+func (s *Server) createTeam(w http.ResponseWriter, r *http.Request, _ []string) {
+	var req struct {
+		Slug string `json:"slug"`
+		Name string `json:"name"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.Slug == "" {
+		writeError(w, http.StatusBadRequest, "bad_request", "slug is required")
+		return
+	}
+	if s.findTeam(req.Slug) != nil {
+		writeError(w, http.StatusConflict, "slug_in_use", "The slug is already in use")
+		return
+	}
+	t := &team{
+		ID:   newID("team_"),
+		Slug: req.Slug,
+		Name: req.Name,
+	}
+	if t.Name == "" {
+		t.Name = t.Slug
+	}
+	s.teams[t.ID] = t
+	writeJSON(w, http.StatusOK, t)
+}
+
+func (s *Server) updateTeam(w http.ResponseWriter, r *http.Request, params []string) {
+	t := s.findTeam(params[0])
+	if t == nil {
+		writeNotFound(w, "Team")
+		return
+	}
+	var req struct {
+		Slug                        *string                   `json:"slug"`
+		Name                        *string                   `json:"name"`
+		Description                 *string                   `json:"description"`
+		Avatar                      *string                   `json:"avatar"`
+		DefaultDeploymentProtection *teamDeploymentProtection `json:"defaultDeploymentProtection"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.Slug != nil && *req.Slug != t.Slug && s.findTeam(*req.Slug) != nil {
+		writeError(w, http.StatusConflict, "slug_in_use", "The slug is already in use")
+		return
+	}
+	if req.Description != nil && len(*req.Description) > 140 {
+		writeValidationError(w, "description", "Invalid request: `description` should NOT be longer than 140 characters")
+		return
+	}
+	if req.Avatar != nil {
+		if _, ok := s.files[*req.Avatar]; !ok {
+			writeError(w, http.StatusBadRequest, "invalid_avatar", "The avatar has not been uploaded")
+			return
+		}
+	}
+
+	if req.Slug != nil {
+		t.Slug = *req.Slug
+	}
+	if req.Name != nil {
+		t.Name = *req.Name
+	}
+	if req.Description != nil {
+		t.Description = req.Description
+		if *req.Description == "" {
+			t.Description = nil
+		}
+	}
+	if req.Avatar != nil {
+		t.Avatar = req.Avatar
+	}
+	if req.DefaultDeploymentProtection != nil {
+		t.DefaultDeploymentProtection = req.DefaultDeploymentProtection
+	}
+	writeJSON(w, http.StatusOK, t)
+}
+
+func (s *Server) getTeam(w http.ResponseWriter, _ *http.Request, params []string) {
+	t := s.findTeam(params[0])
+	if t == nil {
+		writeNotFound(w, "Team")
+		return
+	}
+	writeJSON(w, http.StatusOK, t)
+}
+
+func (s *Server) deleteTeam(w http.ResponseWriter, _ *http.Request, params []string) {
+	t := s.findTeam(params[0])
+	if t == nil {
+		writeNotFound(w, "Team")
+		return
+	}
+	delete(s.teams, t.ID)
+	writeJSON(w, http.StatusOK, map[string]string{"id": t.ID})
+}
+
+func (t *team) findMember(uidOrEmail string) *teamMember {
+	for _, m := range t.members {
+		if m.UID == uidOrEmail || m.Email == uidOrEmail {
+			return m
+		}
+	}
+	return nil
+}
+
+func (s *Server) listTeamMembers(w http.ResponseWriter, r *http.Request, params []string) {
+	t := s.findTeam(params[0])
+	if t == nil {
+		writeNotFound(w, "Team")
+		return
+	}
+	members := append([]*teamMember{}, t.members...)
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].CreatedAt > members[j].CreatedAt
+	})
+	writePage(w, r, "members", members, func(m *teamMember) int64 {
+		return m.CreatedAt
+	})
+}
+
+func (s *Server) createTeamMember(w http.ResponseWriter, r *http.Request, params []string) {
+	t := s.findTeam(params[0])
+	if t == nil {
+		writeNotFound(w, "Team")
+		return
+	}
+	var req struct {
+		UID   string `json:"uid"`
+		Email string `json:"email"`
+		Role  string `json:"role"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.UID == "" && req.Email == "" {
+		writeValidationError(w, "email", "Invalid request: either `uid` or `email` is required")
+		return
+	}
+	if req.Role == "" {
+		req.Role = "MEMBER"
+	}
+	if !teamRoles[req.Role] {
+		writeValidationError(w, "role", "Invalid request: `role` should be equal to one of the allowed values")
+		return
+	}
+	if t.findMember(req.UID) != nil || t.findMember(req.Email) != nil {
+		writeError(w, http.StatusConflict, "already_member", "The user is already a member of the team")
+		return
+	}
+	m := &teamMember{
+		UID:      req.UID,
+		Email:    req.Email,
+		Username: req.UID,
+		Role:     req.Role,
+	}
+	if m.UID == "" {
+		m.UID = newID("usr_")
+		m.Username = strings.SplitN(req.Email, "@", 2)[0]
+	}
+	if m.Email == "" {
+		m.Email = m.Username + "@example.com"
+	}
+	m.CreatedAt = s.now()
+	t.members = append(t.members, m)
+	writeJSON(w, http.StatusOK, m)
+}
+
+func (s *Server) updateTeamMember(w http.ResponseWriter, r *http.Request, params []string) {
+	t := s.findTeam(params[0])
+	if t == nil {
+		writeNotFound(w, "Team")
+		return
+	}
+	m := t.findMember(params[1])
+	if m == nil {
+		writeNotFound(w, "Team member")
+		return
+	}
+	var req struct {
+		Role string `json:"role"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	if !teamRoles[req.Role] {
+		writeValidationError(w, "role", "Invalid request: `role` should be equal to one of the allowed values")
+		return
+	}
+	m.Role = req.Role
+	writeJSON(w, http.StatusOK, map[string]string{"id": m.UID})
+}
+
+func (s *Server) deleteTeamMember(w http.ResponseWriter, _ *http.Request, params []string) {
+	t := s.findTeam(params[0])
+	if t == nil {
+		writeNotFound(w, "Team")
+		return
+	}
+	m := t.findMember(params[1])
+	if m == nil {
+		writeNotFound(w, "Team member")
+		return
+	}
+	for i := range t.members {
+		if t.members[i] == m {
+			t.members = append(t.members[:i], t.members[i+1:]...)
+			break
+		}
+	}
+	writeJSON(w, http.StatusOK, map[string]string{"id": m.UID})
+}
+
+// ConfirmTeamMember marks a member of a team as having accepted their invitation.
+func (s *Server) ConfirmTeamMember(teamID, uid string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if t := s.findTeam(teamID); t != nil {
+		if m := t.findMember(uid); m != nil {
+			m.Confirmed = true
+		}
+	}
+}
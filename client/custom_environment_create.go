@@ -0,0 +1,65 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// BranchMatcher defines which git branches are deployed to a custom environment.
+type BranchMatcher struct {
+	// Type is one of equals, startsWith or endsWith.
+	Type    string `json:"type"`
+	Pattern string `json:"pattern"`
+}
+
+// CustomEnvironmentDomain is a domain that has been assigned to a custom environment.
+type CustomEnvironmentDomain struct {
+	Name string `json:"name"`
+}
+
+// CustomEnvironmentResponse defines the information Vercel returns about a custom environment.
+type CustomEnvironmentResponse struct {
+	ID            string                    `json:"id"`
+	Slug          string                    `json:"slug"`
+	Type          string                    `json:"type"`
+	Description   string                    `json:"description"`
+	BranchMatcher *BranchMatcher            `json:"branchMatcher"`
+	Domains       []CustomEnvironmentDomain `json:"domains"`
+	ProjectID     string                    `json:"-"`
+	TeamID        string                    `json:"-"`
+}
+
+// CreateCustomEnvironmentRequest defines the information necessary to create a custom environment.
+// Custom environments allow a project to have environments other than production, preview and
+// development, such as a staging environment.
+type CreateCustomEnvironmentRequest struct {
+	Slug          string         `json:"slug"`
+	Description   string         `json:"description,omitempty"`
+	BranchMatcher *BranchMatcher `json:"branchMatcher,omitempty"`
+	ProjectID     string         `json:"-"`
+	TeamID        string         `json:"-"`
+}
+
+// CreateCustomEnvironment creates a custom environment within a project.
+func (c *Client) CreateCustomEnvironment(ctx context.Context, request CreateCustomEnvironmentRequest) (r CustomEnvironmentResponse, err error) {
+	url := fmt.Sprintf("%s/v9/projects/%s/custom-environments", c.baseURL, request.ProjectID)
+	if c.teamID(request.TeamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.teamID(request.TeamID))
+	}
+	payload := string(mustMarshal(request))
+	tflog.Trace(c.logContext(ctx), "creating custom environment", map[string]interface{}{
+		"url":     url,
+		"payload": redactPayload(payload),
+	})
+	err = c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "POST",
+		url:    url,
+		body:   payload,
+	}, &r)
+	r.ProjectID = request.ProjectID
+	r.TeamID = c.teamID(request.TeamID)
+	return r, err
+}
@@ -0,0 +1,25 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// DeleteCustomEnvironment removes a custom environment from a project.
+func (c *Client) DeleteCustomEnvironment(ctx context.Context, projectID, teamID, id string) error {
+	url := fmt.Sprintf("%s/v9/projects/%s/custom-environments/%s", c.baseURL, projectID, id)
+	if c.teamID(teamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.teamID(teamID))
+	}
+	tflog.Trace(c.logContext(ctx), "deleting custom environment", map[string]interface{}{
+		"url": url,
+	})
+	return c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "DELETE",
+		url:    url,
+		body:   "",
+	}, nil)
+}
@@ -0,0 +1,28 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// GetCustomEnvironment retrieves a custom environment of a project by its ID or slug.
+func (c *Client) GetCustomEnvironment(ctx context.Context, projectID, teamID, idOrSlug string) (r CustomEnvironmentResponse, err error) {
+	url := fmt.Sprintf("%s/v9/projects/%s/custom-environments/%s", c.baseURL, projectID, idOrSlug)
+	if c.teamID(teamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.teamID(teamID))
+	}
+	tflog.Trace(c.logContext(ctx), "getting custom environment", map[string]interface{}{
+		"url": url,
+	})
+	err = c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "GET",
+		url:    url,
+		body:   "",
+	}, &r)
+	r.ProjectID = projectID
+	r.TeamID = c.teamID(teamID)
+	return r, err
+}
@@ -0,0 +1,133 @@
+package client_test
+
+import (
+	"context"
+	"crypto/sha1"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/vercel/terraform-provider-vercel/client"
+	"github.com/vercel/terraform-provider-vercel/client/clienttest"
+)
+
+func TestCustomEnvironmentLifecycle(t *testing.T) {
+	server := clienttest.NewServer()
+	defer server.Close()
+	c := server.Client()
+	ctx := context.Background()
+
+	project, err := c.CreateProject(ctx, "", client.CreateProjectRequest{Name: "custom-environment-project"})
+	if err != nil {
+		t.Fatalf("unexpected error creating project: %s", err)
+	}
+
+	env, err := c.CreateCustomEnvironment(ctx, client.CreateCustomEnvironmentRequest{
+		Slug:        "Staging",
+		Description: "The staging environment",
+		BranchMatcher: &client.BranchMatcher{
+			Type:    "startsWith",
+			Pattern: "release/",
+		},
+		ProjectID: project.ID,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error creating custom environment: %s", err)
+	}
+	if env.Slug != "staging" {
+		t.Errorf("expected the slug to be derived from the name, got %s", env.Slug)
+	}
+
+	_, err = c.CreateCustomEnvironment(ctx, client.CreateCustomEnvironmentRequest{
+		Slug:      "production",
+		ProjectID: project.ID,
+	})
+	if err == nil {
+		t.Errorf("expected an error creating a custom environment with a reserved name")
+	}
+
+	bySlug, err := c.GetCustomEnvironment(ctx, project.ID, "", "staging")
+	if err != nil {
+		t.Fatalf("unexpected error getting custom environment by slug: %s", err)
+	}
+	if bySlug.ID != env.ID {
+		t.Errorf("expected custom environment %s, got %s", env.ID, bySlug.ID)
+	}
+
+	_, err = c.CreateProjectDomain(ctx, project.ID, "", client.CreateProjectDomainRequest{
+		Name:                "staging.example.com",
+		CustomEnvironmentID: env.ID,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error creating project domain: %s", err)
+	}
+	updated, err := c.UpdateCustomEnvironment(ctx, client.UpdateCustomEnvironmentRequest{
+		Slug:      "staging",
+		ID:        env.ID,
+		ProjectID: project.ID,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error updating custom environment: %s", err)
+	}
+	if updated.BranchMatcher != nil || updated.Description != "" {
+		t.Errorf("expected the branch matcher and description to be removed, got %+v", updated)
+	}
+	if len(updated.Domains) != 1 || updated.Domains[0].Name != "staging.example.com" {
+		t.Errorf("expected the custom environment to include its domain, got %+v", updated.Domains)
+	}
+
+	_, err = c.CreateEnvironmentVariable(ctx, client.CreateEnvironmentVariableRequest{
+		EnvironmentVariable: client.EnvironmentVariableRequest{
+			Key:                  "FOO",
+			Value:                "bar",
+			Target:               []string{},
+			Type:                 "encrypted",
+			CustomEnvironmentIDs: []string{env.ID},
+		},
+		ProjectID: project.ID,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error creating environment variable for custom environment: %s", err)
+	}
+
+	content := "<html></html>"
+	sha := fmt.Sprintf("%x", sha1.Sum([]byte(content)))
+	err = c.CreateFile(ctx, client.CreateFileRequest{
+		Filename: "index.html",
+		SHA:      sha,
+		Content:  strings.NewReader(content),
+		Size:     int64(len(content)),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error uploading file: %s", err)
+	}
+	deployment, err := c.CreateDeployment(ctx, client.CreateDeploymentRequest{
+		Files: []client.DeploymentFile{
+			{File: "index.html", Sha: sha, Size: len(content)},
+		},
+		ProjectID:                 project.ID,
+		CustomEnvironmentSlugOrID: "staging",
+	}, "")
+	if err != nil {
+		t.Fatalf("unexpected error creating deployment: %s", err)
+	}
+	if deployment.CustomEnvironment == nil || deployment.CustomEnvironment.ID != env.ID {
+		t.Errorf("expected the deployment to be made to the custom environment, got %+v", deployment.CustomEnvironment)
+	}
+
+	err = c.DeleteCustomEnvironment(ctx, project.ID, "", env.ID)
+	if err != nil {
+		t.Fatalf("unexpected error deleting custom environment: %s", err)
+	}
+	_, err = c.GetCustomEnvironment(ctx, project.ID, "", env.ID)
+	if !client.NotFound(err) {
+		t.Errorf("expected custom environment to be not found after deletion, got %v", err)
+	}
+	withEnv, err := c.GetProject(ctx, project.ID, "", true)
+	if err != nil {
+		t.Fatalf("unexpected error getting project: %s", err)
+	}
+	if len(withEnv.EnvironmentVariables) != 0 {
+		t.Errorf("expected environment variables for the custom environment to be removed, got %+v", withEnv.EnvironmentVariables)
+	}
+}
@@ -0,0 +1,41 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// UpdateCustomEnvironmentRequest defines the information necessary to update a custom environment.
+type UpdateCustomEnvironmentRequest struct {
+	Slug          string         `json:"slug"`
+	Description   string         `json:"description"`
+	BranchMatcher *BranchMatcher `json:"branchMatcher"`
+	ID            string         `json:"-"`
+	ProjectID     string         `json:"-"`
+	TeamID        string         `json:"-"`
+}
+
+// UpdateCustomEnvironment updates an existing custom environment within a project.
+func (c *Client) UpdateCustomEnvironment(ctx context.Context, request UpdateCustomEnvironmentRequest) (r CustomEnvironmentResponse, err error) {
+	url := fmt.Sprintf("%s/v9/projects/%s/custom-environments/%s", c.baseURL, request.ProjectID, request.ID)
+	if c.teamID(request.TeamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.teamID(request.TeamID))
+	}
+	payload := string(mustMarshal(request))
+	tflog.Trace(c.logContext(ctx), "updating custom environment", map[string]interface{}{
+		"url":     url,
+		"payload": redactPayload(payload),
+	})
+	err = c.doRequest(clientRequest{
+		ctx:        ctx,
+		method:     "PATCH",
+		url:        url,
+		body:       payload,
+		idempotent: true,
+	}, &r)
+	r.ProjectID = request.ProjectID
+	r.TeamID = c.teamID(request.TeamID)
+	return r, err
+}
@@ -0,0 +1,30 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// CancelDeployment cancels a deployment that is still being built within Vercel.
+func (c *Client) CancelDeployment(ctx context.Context, deploymentID, teamID string) (r DeploymentResponse, err error) {
+	url := fmt.Sprintf("%s/v12/deployments/%s/cancel", c.baseURL, deploymentID)
+	if c.teamID(teamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.teamID(teamID))
+	}
+
+	tflog.Trace(c.logContext(ctx), "cancelling deployment", map[string]interface{}{
+		"url": url,
+	})
+	err = c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "PATCH",
+		url:    url,
+		body:   "",
+		// Cancelling an already cancelled deployment has no further effect.
+		idempotent: true,
+	}, &r)
+	r.TeamID = c.teamID(teamID)
+	return r, err
+}
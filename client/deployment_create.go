@@ -38,14 +38,15 @@ type CreateDeploymentRequest struct {
 	Build       struct {
 		Environment map[string]string `json:"env,omitempty"`
 	} `json:"build,omitempty"`
-	ProjectID       string                 `json:"project,omitempty"`
-	ProjectSettings map[string]interface{} `json:"projectSettings"`
-	Name            string                 `json:"name"`
-	Regions         []string               `json:"regions,omitempty"`
-	Routes          []interface{}          `json:"routes,omitempty"`
-	Target          string                 `json:"target,omitempty"`
-	GitSource       *gitSource             `json:"gitSource,omitempty"`
-	Ref             string                 `json:"-"`
+	ProjectID                 string                 `json:"project,omitempty"`
+	ProjectSettings           map[string]interface{} `json:"projectSettings"`
+	Name                      string                 `json:"name"`
+	Regions                   []string               `json:"regions,omitempty"`
+	Routes                    []interface{}          `json:"routes,omitempty"`
+	Target                    string                 `json:"target,omitempty"`
+	CustomEnvironmentSlugOrID string                 `json:"customEnvironmentSlugOrId,omitempty"`
+	GitSource                 *gitSource             `json:"gitSource,omitempty"`
+	Ref                       string                 `json:"-"`
 }
 
 // DeploymentResponse defines the response the Vercel API returns when a deployment is created or updated.
@@ -62,6 +63,7 @@ type DeploymentResponse struct {
 		Action  string `json:"action"`
 	} `json:"aliasWarning"`
 	Creator struct {
+		UID      string `json:"uid"`
 		Username string `json:"username"`
 	} `json:"creator"`
 	Team *struct {
@@ -70,17 +72,53 @@ type DeploymentResponse struct {
 	Build struct {
 		Environment []string `json:"env"`
 	} `json:"build"`
-	AliasAssigned    bool      `json:"aliasAssigned"`
-	ChecksConclusion string    `json:"checksConclusion"`
-	ErrorCode        string    `json:"errorCode"`
-	ErrorMessage     string    `json:"errorMessage"`
-	ID               string    `json:"id"`
-	ProjectID        string    `json:"projectId"`
-	TeamID           string    `json:"-"`
-	ReadyState       string    `json:"readyState"`
-	Target           *string   `json:"target"`
-	URL              string    `json:"url"`
-	GitSource        gitSource `json:"gitSource"`
+	AliasAssigned    bool    `json:"aliasAssigned"`
+	ChecksConclusion string  `json:"checksConclusion"`
+	ErrorCode        string  `json:"errorCode"`
+	ErrorMessage     string  `json:"errorMessage"`
+	ID               string  `json:"id"`
+	ProjectID        string  `json:"projectId"`
+	TeamID           string  `json:"-"`
+	ReadyState       string  `json:"readyState"`
+	Target           *string `json:"target"`
+	// CustomEnvironment is set if the deployment was made to a custom environment.
+	CustomEnvironment *struct {
+		ID   string `json:"id"`
+		Slug string `json:"slug"`
+	} `json:"customEnvironment"`
+	URL       string            `json:"url"`
+	GitSource gitSource         `json:"gitSource"`
+	CreatedAt int64             `json:"createdAt"`
+	Meta      map[string]string `json:"meta"`
+}
+
+// GitRef returns the branch that the deployment was built from, if it was created from a git repository.
+func (dr *DeploymentResponse) GitRef() string {
+	if dr.GitSource.Ref != "" {
+		return dr.GitSource.Ref
+	}
+	return gitMeta(dr.Meta, "CommitRef")
+}
+
+// GitSHA returns the commit that the deployment was built from, if it was created from a git repository.
+func (dr *DeploymentResponse) GitSHA() string {
+	return gitMeta(dr.Meta, "CommitSha")
+}
+
+// GitRepository returns the repository that the deployment was built from, in the format `owner/name`,
+// if it was created from a git repository.
+func (dr *DeploymentResponse) GitRepository() string {
+	switch {
+	case dr.GitSource.Org != "" && dr.GitSource.Repo != "":
+		return dr.GitSource.Org + "/" + dr.GitSource.Repo
+	case dr.GitSource.Owner != "" && dr.GitSource.Slug != "":
+		return dr.GitSource.Owner + "/" + dr.GitSource.Slug
+	}
+	org, repo := gitMeta(dr.Meta, "CommitOrg"), gitMeta(dr.Meta, "CommitRepo")
+	if org == "" || repo == "" {
+		return ""
+	}
+	return org + "/" + repo
 }
 
 // IsComplete is used to determine whether a deployment is still processing, or whether it is fully done.
@@ -109,12 +147,12 @@ func (dr *DeploymentResponse) CheckForError(projectID string) error {
 	}
 
 	if dr.ReadyState == "ERROR" {
-		return fmt.Errorf(
-			"%s - %s. Visit %s for more information",
-			dr.ErrorCode,
-			dr.ErrorMessage,
-			dr.DeploymentLogsURL(projectID),
-		)
+		return DeploymentFailedError{
+			DeploymentID: dr.ID,
+			Code:         dr.ErrorCode,
+			Message:      dr.ErrorMessage,
+			LogsURL:      dr.DeploymentLogsURL(projectID),
+		}
 	}
 
 	if dr.ChecksConclusion == "failed" {
@@ -136,6 +174,19 @@ func (dr *DeploymentResponse) CheckForError(projectID string) error {
 	return nil
 }
 
+// DeploymentFailedError indicates that a deployment was created, but that it failed to build.
+type DeploymentFailedError struct {
+	DeploymentID string
+	Code         string
+	Message      string
+	LogsURL      string
+}
+
+// Error gives the DeploymentFailedError a user friendly error message.
+func (e DeploymentFailedError) Error() string {
+	return fmt.Sprintf("%s - %s. Visit %s for more information", e.Code, e.Message, e.LogsURL)
+}
+
 // MissingFilesError is a sentinel error that indicates a deployment could not be created
 // because additional files need to be uploaded first.
 type MissingFilesError struct {
@@ -201,9 +252,9 @@ func (c *Client) CreateDeployment(ctx context.Context, request CreateDeploymentR
 	}
 	payload := string(mustMarshal(request))
 
-	tflog.Trace(ctx, "creating deployment", map[string]interface{}{
+	tflog.Trace(c.logContext(ctx), "creating deployment", map[string]interface{}{
 		"url":     url,
-		"payload": payload,
+		"payload": redactPayload(payload),
 	})
 	err = c.doRequest(clientRequest{
 		ctx:    ctx,
@@ -230,22 +281,75 @@ func (c *Client) CreateDeployment(ctx context.Context, request CreateDeploymentR
 
 	// Now we've successfully created a deployment, but the deployment process is async.
 	// So poll the deployment until it either fails, or is completed.
+	r, err = c.waitForDeployment(ctx, r, request.ProjectID, teamID)
+	if err != nil {
+		return r, err
+	}
+
+	if r.AliasWarning != nil {
+		// Log out that there is a warning for an alias.
+		log.Printf("[WARN] %s - %s: %s - %s", r.AliasWarning.Code, r.AliasWarning.Message, r.AliasWarning.Action, r.AliasWarning.Link)
+	}
+
+	return r, nil
+}
+
+const (
+	// minDeploymentPollInterval is the initial delay between checks of a deployment's status.
+	minDeploymentPollInterval = 1 * time.Second
+	// maxDeploymentPollInterval bounds the delay between checks of a deployment's status.
+	maxDeploymentPollInterval = 10 * time.Second
+	// deploymentCancelTimeout is how long to spend trying to cancel a deployment that is abandoned.
+	deploymentCancelTimeout = 30 * time.Second
+)
+
+// waitForDeployment polls a deployment until it completes, fails, or the context is done.
+// Builds that are quick are noticed quickly, while long builds are polled progressively less often.
+// If the context is cancelled or times out, the deployment is cancelled so that it does not
+// continue building within Vercel after Terraform has given up on it.
+func (c *Client) waitForDeployment(ctx context.Context, r DeploymentResponse, projectID, teamID string) (DeploymentResponse, error) {
+	interval := minDeploymentPollInterval
 	for !r.IsComplete() {
-		err = r.CheckForError(request.ProjectID)
+		err := r.CheckForError(projectID)
 		if err != nil {
 			return r, err
 		}
-		time.Sleep(5 * time.Second)
-		r, err = c.GetDeployment(ctx, r.ID, teamID)
+		if err := sleep(ctx, interval); err != nil {
+			c.cancelAbandonedDeployment(ctx, r.ID, teamID)
+			return r, fmt.Errorf("deployment %s did not complete: %w", r.ID, err)
+		}
+		interval *= 2
+		if interval > maxDeploymentPollInterval {
+			interval = maxDeploymentPollInterval
+		}
+
+		d, err := c.GetDeployment(ctx, r.ID, teamID)
+		if ctx.Err() != nil {
+			c.cancelAbandonedDeployment(ctx, r.ID, teamID)
+			return r, fmt.Errorf("deployment %s did not complete: %w", r.ID, ctx.Err())
+		}
 		if err != nil {
 			return r, fmt.Errorf("error getting deployment: %w", err)
 		}
+		r = d
 	}
+	return r, nil
+}
 
-	if r.AliasWarning != nil {
-		// Log out that there is a warning for an alias.
-		log.Printf("[WARN] %s - %s: %s - %s", r.AliasWarning.Code, r.AliasWarning.Message, r.AliasWarning.Action, r.AliasWarning.Link)
+// cancelAbandonedDeployment makes a best-effort attempt at cancelling a deployment. The
+// original context is already done, so a new one is used for the request.
+func (c *Client) cancelAbandonedDeployment(ctx context.Context, deploymentID, teamID string) {
+	cancelCtx, cancel := context.WithTimeout(context.Background(), deploymentCancelTimeout)
+	defer cancel()
+	_, err := c.CancelDeployment(cancelCtx, deploymentID, teamID)
+	if err != nil {
+		tflog.Warn(c.logContext(ctx), "unable to cancel deployment", map[string]interface{}{
+			"deployment_id": deploymentID,
+			"error":         err.Error(),
+		})
+		return
 	}
-
-	return r, nil
+	tflog.Info(c.logContext(ctx), "cancelled deployment", map[string]interface{}{
+		"deployment_id": deploymentID,
+	})
 }
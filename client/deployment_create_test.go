@@ -0,0 +1,177 @@
+package client_test
+
+import (
+	"context"
+	"crypto/sha1"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vercel/terraform-provider-vercel/client"
+	"github.com/vercel/terraform-provider-vercel/client/clienttest"
+)
+
+func TestCreateDeploymentMissingFiles(t *testing.T) {
+	server := clienttest.NewServer()
+	defer server.Close()
+	c := server.Client()
+	ctx := context.Background()
+
+	project, err := c.CreateProject(ctx, "", client.CreateProjectRequest{Name: "deployment-project"})
+	if err != nil {
+		t.Fatalf("unexpected error creating project: %s", err)
+	}
+
+	content := "<html></html>"
+	sha := fmt.Sprintf("%x", sha1.Sum([]byte(content)))
+	request := client.CreateDeploymentRequest{
+		Files: []client.DeploymentFile{
+			{File: "index.html", Sha: sha, Size: len(content)},
+		},
+		ProjectID: project.ID,
+		Target:    "production",
+	}
+
+	_, err = c.CreateDeployment(ctx, request, "")
+	var mfErr client.MissingFilesError
+	if !errors.As(err, &mfErr) {
+		t.Fatalf("expected a MissingFilesError, got %v", err)
+	}
+	if len(mfErr.Missing) != 1 || mfErr.Missing[0] != sha {
+		t.Fatalf("expected %s to be missing, got %v", sha, mfErr.Missing)
+	}
+
+	err = c.CreateFile(ctx, client.CreateFileRequest{
+		Filename: "index.html",
+		SHA:      "0000000000000000000000000000000000000000",
+		Content:  strings.NewReader(content),
+		Size:     int64(len(content)),
+	})
+	if err == nil {
+		t.Errorf("expected an error uploading a file with a mismatched SHA")
+	}
+
+	err = c.CreateFile(ctx, client.CreateFileRequest{
+		Filename: "index.html",
+		SHA:      sha,
+		Content:  strings.NewReader(content),
+		Size:     int64(len(content)),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error uploading file: %s", err)
+	}
+
+	deployment, err := c.CreateDeployment(ctx, request, "")
+	if err != nil {
+		t.Fatalf("unexpected error creating deployment: %s", err)
+	}
+	if deployment.ReadyState != "READY" {
+		t.Errorf("expected deployment to be READY, got %s", deployment.ReadyState)
+	}
+	if deployment.ProjectID != project.ID {
+		t.Errorf("expected deployment to belong to project %s, got %s", project.ID, deployment.ProjectID)
+	}
+}
+
+func TestCreateDeploymentError(t *testing.T) {
+	server := clienttest.NewServer()
+	defer server.Close()
+	c := server.Client()
+	ctx := context.Background()
+
+	project, err := c.CreateProject(ctx, "", client.CreateProjectRequest{Name: "deployment-project"})
+	if err != nil {
+		t.Fatalf("unexpected error creating project: %s", err)
+	}
+	content := "<html></html>"
+	sha := fmt.Sprintf("%x", sha1.Sum([]byte(content)))
+	err = c.CreateFile(ctx, client.CreateFileRequest{
+		Filename: "index.html",
+		SHA:      sha,
+		Content:  strings.NewReader(content),
+		Size:     int64(len(content)),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error uploading file: %s", err)
+	}
+
+	server.SetDeploymentBehaviour(clienttest.DeploymentBehaviour{
+		ReadyState:   "ERROR",
+		ErrorCode:    "BUILD_UTILS_SPAWN_1",
+		ErrorMessage: "Command \"npm run build\" exited with 1",
+		BuildLogs: []string{
+			"Running \"npm run build\"",
+			"Error: Cannot find module 'next'\nRequire stack:",
+			"Error: Command \"npm run build\" exited with 1",
+		},
+	})
+	_, err = c.CreateDeployment(ctx, client.CreateDeploymentRequest{
+		Files: []client.DeploymentFile{
+			{File: "index.html", Sha: sha, Size: len(content)},
+		},
+		ProjectID: project.ID,
+	}, "")
+	if err == nil || !strings.Contains(err.Error(), "BUILD_UTILS_SPAWN_1") {
+		t.Fatalf("expected the deployment error to be surfaced, got %v", err)
+	}
+	var failedErr client.DeploymentFailedError
+	if !errors.As(err, &failedErr) {
+		t.Fatalf("expected a DeploymentFailedError, got %v", err)
+	}
+
+	logs, err := c.GetDeploymentLogs(ctx, failedErr.DeploymentID, "")
+	if err != nil {
+		t.Fatalf("unexpected error getting deployment logs: %s", err)
+	}
+	expected := []string{
+		"Running \"npm run build\"",
+		"Error: Cannot find module 'next'",
+		"Require stack:",
+		"Error: Command \"npm run build\" exited with 1",
+	}
+	if strings.Join(logs, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("expected build logs %q, got %q", expected, logs)
+	}
+}
+
+func TestCreateDeploymentCancelledOnTimeout(t *testing.T) {
+	server := clienttest.NewServer()
+	defer server.Close()
+	c := server.Client()
+	ctx := context.Background()
+
+	project, err := c.CreateProject(ctx, "", client.CreateProjectRequest{Name: "deployment-project"})
+	if err != nil {
+		t.Fatalf("unexpected error creating project: %s", err)
+	}
+	content := "<html></html>"
+	sha := fmt.Sprintf("%x", sha1.Sum([]byte(content)))
+	err = c.CreateFile(ctx, client.CreateFileRequest{
+		Filename: "index.html",
+		SHA:      sha,
+		Content:  strings.NewReader(content),
+		Size:     int64(len(content)),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error uploading file: %s", err)
+	}
+
+	// The deployment will never finish building within the timeout.
+	server.SetDeploymentBehaviour(clienttest.DeploymentBehaviour{PendingPolls: 1000})
+	timeoutCtx, cancel := context.WithTimeout(ctx, 200*time.Millisecond)
+	defer cancel()
+	deployment, err := c.CreateDeployment(timeoutCtx, client.CreateDeploymentRequest{
+		Files: []client.DeploymentFile{
+			{File: "index.html", Sha: sha, Size: len(content)},
+		},
+		ProjectID: project.ID,
+	}, "")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the deployment to time out, got %v", err)
+	}
+	if state := server.DeploymentState(deployment.ID); state != "CANCELED" {
+		t.Errorf("expected the deployment to be cancelled, got %s", state)
+	}
+}
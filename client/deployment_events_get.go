@@ -0,0 +1,63 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// DeploymentEvent is a single event that occurred while a deployment was being built,
+// such as a line of output from the build.
+type DeploymentEvent struct {
+	Type    string `json:"type"`
+	Created int64  `json:"created"`
+	Text    string `json:"text"`
+	Payload struct {
+		Text string `json:"text"`
+	} `json:"payload"`
+}
+
+// LogLine returns the text of the event as it would appear in the build log.
+func (e DeploymentEvent) LogLine() string {
+	if e.Text != "" {
+		return e.Text
+	}
+	return e.Payload.Text
+}
+
+// GetDeploymentEvents retrieves the build events, including the build log, for a deployment from Vercel.
+func (c *Client) GetDeploymentEvents(ctx context.Context, deploymentID, teamID string) (r []DeploymentEvent, err error) {
+	url := fmt.Sprintf("%s/v3/deployments/%s/events?builds=1&direction=forward&limit=-1", c.baseURL, deploymentID)
+	if c.teamID(teamID) != "" {
+		url = fmt.Sprintf("%s&teamId=%s", url, c.teamID(teamID))
+	}
+
+	tflog.Trace(c.logContext(ctx), "getting deployment events", map[string]interface{}{
+		"url": url,
+	})
+	err = c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "GET",
+		url:    url,
+		body:   "",
+	}, &r)
+	return r, err
+}
+
+// GetDeploymentLogs retrieves the build log for a deployment from Vercel, as a list of lines.
+func (c *Client) GetDeploymentLogs(ctx context.Context, deploymentID, teamID string) ([]string, error) {
+	events, err := c.GetDeploymentEvents(ctx, deploymentID, teamID)
+	if err != nil {
+		return nil, err
+	}
+	var lines []string
+	for _, e := range events {
+		if e.Type != "stdout" && e.Type != "stderr" && e.Type != "command" {
+			continue
+		}
+		lines = append(lines, strings.Split(strings.TrimRight(e.LogLine(), "\n"), "\n")...)
+	}
+	return lines, nil
+}
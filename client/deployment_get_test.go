@@ -0,0 +1,60 @@
+package client_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vercel/terraform-provider-vercel/client"
+	"github.com/vercel/terraform-provider-vercel/client/clienttest"
+)
+
+func TestGetDeploymentByURL(t *testing.T) {
+	server := clienttest.NewServer()
+	defer server.Close()
+	c := server.Client()
+	ctx := context.Background()
+
+	project, err := c.CreateProject(ctx, "", client.CreateProjectRequest{
+		Name: "get-deployment",
+		GitRepository: &client.GitRepository{
+			Type: "github",
+			Repo: "vercel/next.js",
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error creating project: %s", err)
+	}
+	created, err := c.CreateDeployment(ctx, client.CreateDeploymentRequest{
+		ProjectID: project.ID,
+		Ref:       "main",
+		Target:    "production",
+	}, "")
+	if err != nil {
+		t.Fatalf("unexpected error creating deployment: %s", err)
+	}
+
+	deployment, err := c.GetDeployment(ctx, created.URL, "")
+	if err != nil {
+		t.Fatalf("unexpected error getting deployment by url: %s", err)
+	}
+	if deployment.ID != created.ID {
+		t.Errorf("expected deployment %s, got %s", created.ID, deployment.ID)
+	}
+	if deployment.GitRef() != "main" {
+		t.Errorf("expected the deployment to be built from main, got %q", deployment.GitRef())
+	}
+	if deployment.GitRepository() != "vercel/next.js" {
+		t.Errorf("expected the deployment to be built from vercel/next.js, got %q", deployment.GitRepository())
+	}
+	if deployment.GitSHA() == "" {
+		t.Errorf("expected the deployment to have a commit sha")
+	}
+	if deployment.Creator.UID == "" {
+		t.Errorf("expected the deployment to have a creator")
+	}
+
+	_, err = c.GetDeployment(ctx, "missing.vercel.app", "")
+	if !client.NotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
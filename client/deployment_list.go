@@ -0,0 +1,99 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// DeploymentSummary is the information Vercel returns about each deployment when listing deployments.
+type DeploymentSummary struct {
+	ID      string  `json:"uid"`
+	Name    string  `json:"name"`
+	URL     string  `json:"url"`
+	Created int64   `json:"created"`
+	State   string  `json:"state"`
+	Target  *string `json:"target"`
+	Creator struct {
+		UID      string `json:"uid"`
+		Username string `json:"username"`
+	} `json:"creator"`
+	Meta   map[string]string `json:"meta"`
+	TeamID string            `json:"-"`
+}
+
+// gitProviders are the git providers Vercel can deploy from. Deployments made by one of these providers
+// include details of the commit in their metadata, prefixed with the provider name.
+var gitProviders = []string{"github", "gitlab", "bitbucket"}
+
+func gitMeta(meta map[string]string, suffix string) string {
+	for _, provider := range gitProviders {
+		if v := meta[provider+suffix]; v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// GitRef returns the branch that the deployment was built from, if it was created from a git repository.
+func (d DeploymentSummary) GitRef() string {
+	return gitMeta(d.Meta, "CommitRef")
+}
+
+// GitSHA returns the commit that the deployment was built from, if it was created from a git repository.
+func (d DeploymentSummary) GitSHA() string {
+	return gitMeta(d.Meta, "CommitSha")
+}
+
+// ListDeploymentsRequest defines the filters that can be used when listing deployments. All of the
+// filters are optional.
+type ListDeploymentsRequest struct {
+	ProjectID string
+	// Target is either `production` or `preview`.
+	Target string
+	// State only includes deployments in the given ready state, such as `READY` or `ERROR`.
+	State string
+	// GitRef and GitSHA only include deployments built from the given branch or commit. Vercel
+	// does not support filtering on these, so they are filtered by the client.
+	GitRef string
+	GitSHA string
+	// Limit is the maximum number of deployments to return. Zero means all deployments are returned.
+	Limit   int
+	Filters ListFilters
+	TeamID  string
+}
+
+func (r ListDeploymentsRequest) matches(d DeploymentSummary) bool {
+	return (r.GitRef == "" || d.GitRef() == r.GitRef) &&
+		(r.GitSHA == "" || d.GitSHA() == r.GitSHA)
+}
+
+// ListDeployments lists the deployments within Vercel that match the request, newest first.
+func (c *Client) ListDeployments(ctx context.Context, request ListDeploymentsRequest) (r []DeploymentSummary, err error) {
+	url := fmt.Sprintf("%s/v6/deployments", c.baseURL)
+	query := c.listQuery(request.TeamID, request.Filters)
+	if request.ProjectID != "" {
+		query.Set("projectId", request.ProjectID)
+	}
+	if request.Target != "" {
+		query.Set("target", request.Target)
+	}
+	if request.State != "" {
+		query.Set("state", request.State)
+	}
+
+	r = []DeploymentSummary{}
+	err = listPages(ctx, c, url, query, "deployments", func(deployments []DeploymentSummary) error {
+		for _, d := range deployments {
+			if !request.matches(d) {
+				continue
+			}
+			d.TeamID = c.teamID(request.TeamID)
+			r = append(r, d)
+			if request.Limit > 0 && len(r) == request.Limit {
+				return errStopListing
+			}
+		}
+		return nil
+	})
+	return r, err
+}
@@ -0,0 +1,86 @@
+package client_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vercel/terraform-provider-vercel/client"
+	"github.com/vercel/terraform-provider-vercel/client/clienttest"
+)
+
+func TestListDeployments(t *testing.T) {
+	server := clienttest.NewServer()
+	defer server.Close()
+	c := server.Client()
+	ctx := context.Background()
+
+	project, err := c.CreateProject(ctx, "", client.CreateProjectRequest{
+		Name: "list-deployments",
+		GitRepository: &client.GitRepository{
+			Type: "github",
+			Repo: "vercel/next.js",
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error creating project: %s", err)
+	}
+	deploy := func(ref, target string) client.DeploymentResponse {
+		d, err := c.CreateDeployment(ctx, client.CreateDeploymentRequest{
+			ProjectID: project.ID,
+			Ref:       ref,
+			Target:    target,
+		}, "")
+		if err != nil {
+			t.Fatalf("unexpected error creating deployment: %s", err)
+		}
+		return d
+	}
+	deploy("main", "production")
+	deploy("feature", "")
+	latestProduction := deploy("main", "production")
+	server.SetDeploymentBehaviour(clienttest.DeploymentBehaviour{ReadyState: "ERROR"})
+	_, err = c.CreateDeployment(ctx, client.CreateDeploymentRequest{ProjectID: project.ID, Ref: "feature"}, "")
+	if err == nil {
+		t.Fatalf("expected the failed deployment to return an error")
+	}
+
+	deployments, err := c.ListDeployments(ctx, client.ListDeploymentsRequest{ProjectID: project.ID})
+	if err != nil {
+		t.Fatalf("unexpected error listing deployments: %s", err)
+	}
+	if len(deployments) != 4 {
+		t.Fatalf("expected 4 deployments, got %d", len(deployments))
+	}
+	for i := 1; i < len(deployments); i++ {
+		if deployments[i].Created > deployments[i-1].Created {
+			t.Errorf("expected deployments to be listed newest first")
+		}
+	}
+
+	deployments, err = c.ListDeployments(ctx, client.ListDeploymentsRequest{
+		ProjectID: project.ID,
+		Target:    "production",
+		Limit:     1,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error listing deployments: %s", err)
+	}
+	if len(deployments) != 1 || deployments[0].ID != latestProduction.ID {
+		t.Errorf("expected only the latest production deployment %s, got %+v", latestProduction.ID, deployments)
+	}
+
+	deployments, err = c.ListDeployments(ctx, client.ListDeploymentsRequest{
+		ProjectID: project.ID,
+		State:     "READY",
+		GitRef:    "feature",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error listing deployments: %s", err)
+	}
+	if len(deployments) != 1 || deployments[0].GitRef() != "feature" || deployments[0].State != "READY" {
+		t.Errorf("expected the single READY deployment of the feature branch, got %+v", deployments)
+	}
+	if deployments[0].GitSHA() == "" {
+		t.Errorf("expected the deployment to include the commit SHA")
+	}
+}
@@ -32,15 +32,16 @@ func (c *Client) UpdateDNSRecord(ctx context.Context, teamID, recordID string, r
 	}
 
 	payload := string(mustMarshal(request))
-	tflog.Trace(ctx, "updating DNS record", map[string]interface{}{
+	tflog.Trace(c.logContext(ctx), "updating DNS record", map[string]interface{}{
 		"url":     url,
-		"payload": payload,
+		"payload": redactPayload(payload),
 	})
 	err = c.doRequest(clientRequest{
-		ctx:    ctx,
-		method: "PATCH",
-		url:    url,
-		body:   payload,
+		ctx:        ctx,
+		method:     "PATCH",
+		url:        url,
+		body:       payload,
+		idempotent: true,
 	}, &r)
 	r.TeamID = c.teamID(teamID)
 	return r, err
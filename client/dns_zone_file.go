@@ -0,0 +1,396 @@
+package client
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// zoneFileToken is a single field of a zone file entry.
+type zoneFileToken struct {
+	text   string
+	quoted bool
+}
+
+// zoneFileEntry is a logical line of a zone file, which may span several physical lines if it uses
+// parentheses.
+type zoneFileEntry struct {
+	line int
+	// inheritsOwner is set if the entry begins with whitespace, meaning it has no owner name and
+	// uses the owner of the previous entry.
+	inheritsOwner bool
+	tokens        []zoneFileToken
+}
+
+// tokenizeZoneFile splits the content of a zone file into entries, removing comments and joining
+// entries that are split over multiple lines by parentheses.
+func tokenizeZoneFile(content string) ([]zoneFileEntry, error) {
+	var entries []zoneFileEntry
+	line := 1
+	entry := zoneFileEntry{line: line}
+	var current strings.Builder
+	inToken, inQuote, inComment, atLineStart := false, false, false, true
+	depth := 0
+
+	endToken := func(quoted bool) {
+		if inToken || quoted {
+			entry.tokens = append(entry.tokens, zoneFileToken{text: current.String(), quoted: quoted})
+		}
+		current.Reset()
+		inToken = false
+	}
+	endEntry := func() {
+		if len(entry.tokens) > 0 {
+			entries = append(entries, entry)
+		}
+		entry = zoneFileEntry{line: line}
+	}
+
+	runes := []rune(content)
+	for i := 0; i < len(runes); i++ {
+		c := runes[i]
+		if inQuote {
+			switch c {
+			case '\\':
+				if i+1 < len(runes) {
+					i++
+					current.WriteRune(runes[i])
+				}
+			case '"':
+				inQuote = false
+				endToken(true)
+			case '\n':
+				return nil, fmt.Errorf("line %d: unterminated quoted string", line)
+			default:
+				current.WriteRune(c)
+			}
+			continue
+		}
+		if inComment && c != '\n' {
+			continue
+		}
+		switch c {
+		case '\n':
+			endToken(false)
+			inComment = false
+			line++
+			if depth == 0 {
+				endEntry()
+			}
+			atLineStart = true
+			continue
+		case ' ', '\t', '\r':
+			if atLineStart && depth == 0 && len(entry.tokens) == 0 {
+				entry.inheritsOwner = true
+			}
+			endToken(false)
+		case ';':
+			endToken(false)
+			inComment = true
+		case '"':
+			endToken(false)
+			inQuote = true
+		case '(':
+			endToken(false)
+			depth++
+		case ')':
+			endToken(false)
+			if depth == 0 {
+				return nil, fmt.Errorf("line %d: unexpected )", line)
+			}
+			depth--
+		default:
+			inToken = true
+			current.WriteRune(c)
+		}
+		atLineStart = false
+	}
+	if inQuote {
+		return nil, fmt.Errorf("line %d: unterminated quoted string", line)
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("line %d: unterminated (", line)
+	}
+	endToken(false)
+	endEntry()
+	return entries, nil
+}
+
+// parseZoneFileTTL parses a TTL in seconds, which may use BIND's unit suffixes, such as 1h30m.
+func parseZoneFileTTL(s string) (int64, bool) {
+	if s == "" {
+		return 0, false
+	}
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return n, n >= 0
+	}
+	units := map[byte]int64{'s': 1, 'm': 60, 'h': 60 * 60, 'd': 24 * 60 * 60, 'w': 7 * 24 * 60 * 60}
+	var total, n int64
+	digits := false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c >= '0' && c <= '9':
+			n = n*10 + int64(c-'0')
+			digits = true
+		case digits && units[c|0x20] != 0:
+			total += n * units[c|0x20]
+			n, digits = 0, false
+		default:
+			return 0, false
+		}
+	}
+	if digits {
+		return 0, false
+	}
+	return total, true
+}
+
+// qualifyName makes a name from a zone file fully qualified, relative to the origin.
+func qualifyName(name, origin string) string {
+	if name == "@" {
+		return origin
+	}
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+	return name + "." + origin
+}
+
+// ParseZoneFile parses the records within a BIND zone file for a domain, so that they can be created
+// within Vercel. SOA records are ignored, as they are managed by Vercel. Hostnames within record
+// values are returned fully qualified, with a trailing dot.
+func ParseZoneFile(domain, content string) ([]CreateDNSRecordRequest, error) {
+	apex := strings.ToLower(strings.TrimSuffix(domain, ".")) + "."
+	origin := apex
+	var defaultTTL int64
+	previousOwner := ""
+
+	entries, err := tokenizeZoneFile(content)
+	if err != nil {
+		return nil, err
+	}
+	var records []CreateDNSRecordRequest
+	for _, entry := range entries {
+		tokens := entry.tokens
+		if !tokens[0].quoted && strings.HasPrefix(tokens[0].text, "$") {
+			directive := strings.ToUpper(tokens[0].text)
+			if len(tokens) < 2 {
+				return nil, fmt.Errorf("line %d: %s requires a value", entry.line, directive)
+			}
+			switch directive {
+			case "$ORIGIN":
+				origin = strings.ToLower(qualifyName(tokens[1].text, origin))
+			case "$TTL":
+				ttl, ok := parseZoneFileTTL(tokens[1].text)
+				if !ok {
+					return nil, fmt.Errorf("line %d: invalid TTL %q", entry.line, tokens[1].text)
+				}
+				defaultTTL = ttl
+			default:
+				return nil, fmt.Errorf("line %d: unsupported directive %s", entry.line, directive)
+			}
+			continue
+		}
+
+		owner := previousOwner
+		if !entry.inheritsOwner {
+			owner = strings.ToLower(qualifyName(tokens[0].text, origin))
+			tokens = tokens[1:]
+		}
+		if owner == "" {
+			return nil, fmt.Errorf("line %d: record has no owner name", entry.line)
+		}
+		previousOwner = owner
+
+		ttl := defaultTTL
+		for len(tokens) > 0 && !tokens[0].quoted {
+			if strings.EqualFold(tokens[0].text, "IN") {
+				tokens = tokens[1:]
+				continue
+			}
+			t, ok := parseZoneFileTTL(tokens[0].text)
+			if !ok {
+				break
+			}
+			ttl = t
+			tokens = tokens[1:]
+		}
+		if len(tokens) == 0 {
+			return nil, fmt.Errorf("line %d: record has no type", entry.line)
+		}
+
+		var name string
+		switch {
+		case owner == apex:
+			name = ""
+		case strings.HasSuffix(owner, "."+apex):
+			name = strings.TrimSuffix(owner, "."+apex)
+		default:
+			return nil, fmt.Errorf("line %d: %s is not within the domain %s", entry.line, owner, strings.TrimSuffix(apex, "."))
+		}
+
+		recordType := strings.ToUpper(tokens[0].text)
+		rdata := tokens[1:]
+		expectFields := func(n int) error {
+			if len(rdata) != n {
+				return fmt.Errorf("line %d: expected %d fields for %s record, but got %d", entry.line, n, recordType, len(rdata))
+			}
+			return nil
+		}
+		record := CreateDNSRecordRequest{
+			Domain: strings.TrimSuffix(apex, "."),
+			Name:   name,
+			TTL:    ttl,
+			Type:   recordType,
+		}
+		switch recordType {
+		case "SOA":
+			continue
+		case "A", "AAAA":
+			if err := expectFields(1); err != nil {
+				return nil, err
+			}
+			record.Value = rdata[0].text
+		case "ALIAS", "CNAME", "NS":
+			if err := expectFields(1); err != nil {
+				return nil, err
+			}
+			record.Value = qualifyName(rdata[0].text, origin)
+		case "MX":
+			if err := expectFields(2); err != nil {
+				return nil, err
+			}
+			priority, err := strconv.ParseInt(rdata[0].text, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: expected MX priority to be an int, but got %s", entry.line, rdata[0].text)
+			}
+			record.MXPriority = priority
+			record.Value = qualifyName(rdata[1].text, origin)
+		case "SRV":
+			if err := expectFields(4); err != nil {
+				return nil, err
+			}
+			var fields [3]int64
+			for i := range fields {
+				fields[i], err = strconv.ParseInt(rdata[i].text, 10, 64)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: expected SRV priority, weight and port to be ints, but got %s", entry.line, rdata[i].text)
+				}
+			}
+			target := ""
+			if rdata[3].text != "." {
+				target = qualifyName(rdata[3].text, origin)
+			}
+			record.SRV = &SRV{
+				Priority: fields[0],
+				Weight:   fields[1],
+				Port:     fields[2],
+				Target:   target,
+			}
+		case "CAA":
+			if err := expectFields(3); err != nil {
+				return nil, err
+			}
+			record.Value = fmt.Sprintf("%s %s %q", rdata[0].text, rdata[1].text, rdata[2].text)
+		case "TXT":
+			if len(rdata) == 0 {
+				return nil, fmt.Errorf("line %d: expected a value for TXT record", entry.line)
+			}
+			var value strings.Builder
+			for _, t := range rdata {
+				value.WriteString(t.text)
+			}
+			record.Value = value.String()
+		default:
+			return nil, fmt.Errorf("line %d: unsupported record type %s", entry.line, recordType)
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// fullyQualified adds a trailing dot to a hostname, so that it is not treated as relative to the
+// origin of a zone file.
+func fullyQualified(hostname string) string {
+	if hostname == "" || strings.HasSuffix(hostname, ".") {
+		return hostname
+	}
+	return hostname + "."
+}
+
+// quoteTXT formats the value of a TXT record as one or more quoted strings, as each string within a
+// zone file is limited to 255 characters.
+func quoteTXT(value string) string {
+	var parts []string
+	for {
+		chunk := value
+		if len(chunk) > 255 {
+			// Avoid splitting a multi-byte character between strings.
+			end := 255
+			for end > 0 && !utf8.RuneStart(chunk[end]) {
+				end--
+			}
+			chunk = chunk[:end]
+		}
+		value = value[len(chunk):]
+		escaped := strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(chunk)
+		parts = append(parts, `"`+escaped+`"`)
+		if value == "" {
+			return strings.Join(parts, " ")
+		}
+	}
+}
+
+// zoneFileRecordData formats the value of a DNS record as it should appear within a zone file.
+func zoneFileRecordData(r DNSRecord) string {
+	switch r.RecordType {
+	case "ALIAS", "CNAME", "NS":
+		return fullyQualified(r.Value)
+	case "MX":
+		parts := strings.SplitN(r.Value, " ", 2)
+		if len(parts) == 2 {
+			return parts[0] + " " + fullyQualified(parts[1])
+		}
+	case "SRV":
+		parts := strings.Split(r.Value, " ")
+		if len(parts) == 3 {
+			return r.Value + " ."
+		}
+		if len(parts) == 4 {
+			parts[3] = fullyQualified(parts[3])
+			return strings.Join(parts, " ")
+		}
+	case "TXT":
+		return quoteTXT(r.Value)
+	}
+	return r.Value
+}
+
+// FormatZoneFile renders the DNS records of a domain as a BIND zone file. Records are sorted by
+// name, type and value, so that the output is stable.
+func FormatZoneFile(domain string, records []DNSRecord) string {
+	sorted := append([]DNSRecord{}, records...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Name != sorted[j].Name {
+			return sorted[i].Name < sorted[j].Name
+		}
+		if sorted[i].RecordType != sorted[j].RecordType {
+			return sorted[i].RecordType < sorted[j].RecordType
+		}
+		return sorted[i].Value < sorted[j].Value
+	})
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "$ORIGIN %s.\n", strings.TrimSuffix(domain, "."))
+	for _, r := range sorted {
+		name := r.Name
+		if name == "" {
+			name = "@"
+		}
+		fmt.Fprintf(&b, "%s\t%d\tIN\t%s\t%s\n", name, r.TTL, r.RecordType, zoneFileRecordData(r))
+	}
+	return b.String()
+}
@@ -0,0 +1,108 @@
+package client_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+const testZoneFile = `
+$ORIGIN example.com.
+$TTL 1h
+@	IN	SOA	ns1.example.com. hostmaster.example.com. (
+		2023010101 ; serial
+		7200       ; refresh
+		3600       ; retry
+		1209600    ; expire
+		3600 )     ; minimum
+@		A	76.76.21.21
+		AAAA	::1 ; the owner is inherited from the previous record
+www	300	IN	CNAME	cname.vercel-dns.com.
+app		ALIAS	www
+@	IN	60	MX	10 mail
+@		CAA	0 issue "letsencrypt.org"
+_sip._tcp	SRV	10 5 5060 sip.example.com.
+_null._tcp	SRV	0 0 0 .
+@		TXT	"v=spf1 include:_spf.example.com ~all"
+long		TXT	"part one; " "part \"two\""
+$ORIGIN sub.example.com.
+ns		NS	ns1.vercel-dns.com.
+`
+
+func TestParseZoneFile(t *testing.T) {
+	records, err := client.ParseZoneFile("example.com", testZoneFile)
+	if err != nil {
+		t.Fatalf("unexpected error parsing zone file: %s", err)
+	}
+	expected := []client.CreateDNSRecordRequest{
+		{Domain: "example.com", Name: "", TTL: 3600, Type: "A", Value: "76.76.21.21"},
+		{Domain: "example.com", Name: "", TTL: 3600, Type: "AAAA", Value: "::1"},
+		{Domain: "example.com", Name: "www", TTL: 300, Type: "CNAME", Value: "cname.vercel-dns.com."},
+		{Domain: "example.com", Name: "app", TTL: 3600, Type: "ALIAS", Value: "www.example.com."},
+		{Domain: "example.com", Name: "", TTL: 60, Type: "MX", MXPriority: 10, Value: "mail.example.com."},
+		{Domain: "example.com", Name: "", TTL: 3600, Type: "CAA", Value: `0 issue "letsencrypt.org"`},
+		{Domain: "example.com", Name: "_sip._tcp", TTL: 3600, Type: "SRV", SRV: &client.SRV{Priority: 10, Weight: 5, Port: 5060, Target: "sip.example.com."}},
+		{Domain: "example.com", Name: "_null._tcp", TTL: 3600, Type: "SRV", SRV: &client.SRV{}},
+		{Domain: "example.com", Name: "", TTL: 3600, Type: "TXT", Value: "v=spf1 include:_spf.example.com ~all"},
+		{Domain: "example.com", Name: "long", TTL: 3600, Type: "TXT", Value: `part one; part "two"`},
+		{Domain: "example.com", Name: "ns.sub", TTL: 3600, Type: "NS", Value: "ns1.vercel-dns.com."},
+	}
+	if !reflect.DeepEqual(records, expected) {
+		t.Errorf("expected records:\n%+v\ngot:\n%+v", expected, records)
+	}
+}
+
+func TestParseZoneFileErrors(t *testing.T) {
+	for _, tc := range []struct {
+		content string
+		err     string
+	}{
+		{content: "www A 127.0.0.1\nwww PTR example.com.", err: "line 2: unsupported record type PTR"},
+		{content: "other.com. A 127.0.0.1", err: "other.com. is not within the domain example.com"},
+		{content: "@ MX mail.example.com.", err: "expected 2 fields for MX record"},
+		{content: "@ TXT \"unterminated", err: "unterminated quoted string"},
+		{content: "$INCLUDE other.zone", err: "unsupported directive $INCLUDE"},
+		{content: "\tA 127.0.0.1", err: "record has no owner name"},
+	} {
+		_, err := client.ParseZoneFile("example.com", tc.content)
+		if err == nil || !strings.Contains(err.Error(), tc.err) {
+			t.Errorf("expected parsing %q to fail with %q, got %v", tc.content, tc.err, err)
+		}
+	}
+}
+
+func TestFormatZoneFile(t *testing.T) {
+	records := []client.DNSRecord{
+		{Name: "www", TTL: 60, RecordType: "CNAME", Value: "cname.vercel-dns.com"},
+		{Name: "", TTL: 60, RecordType: "MX", Value: "10 mail.example.com"},
+		{Name: "", TTL: 3600, RecordType: "A", Value: "76.76.21.21"},
+		{Name: "_sip._tcp", TTL: 60, RecordType: "SRV", Value: "10 5 5060 sip.example.com"},
+		{Name: "", TTL: 60, RecordType: "TXT", Value: `say "hello"`},
+		{Name: "long", TTL: 60, RecordType: "TXT", Value: strings.Repeat("a", 300)},
+	}
+	content := client.FormatZoneFile("example.com", records)
+	expected := "$ORIGIN example.com.\n" +
+		"@\t3600\tIN\tA\t76.76.21.21\n" +
+		"@\t60\tIN\tMX\t10 mail.example.com.\n" +
+		"@\t60\tIN\tTXT\t\"say \\\"hello\\\"\"\n" +
+		"_sip._tcp\t60\tIN\tSRV\t10 5 5060 sip.example.com.\n" +
+		"long\t60\tIN\tTXT\t\"" + strings.Repeat("a", 255) + "\" \"" + strings.Repeat("a", 45) + "\"\n" +
+		"www\t60\tIN\tCNAME\tcname.vercel-dns.com.\n"
+	if content != expected {
+		t.Errorf("expected zone file:\n%s\ngot:\n%s", expected, content)
+	}
+
+	// The formatted zone file should parse back into the same records.
+	parsed, err := client.ParseZoneFile("example.com", content)
+	if err != nil {
+		t.Fatalf("unexpected error parsing formatted zone file: %s", err)
+	}
+	if len(parsed) != len(records) {
+		t.Fatalf("expected %d records, got %d", len(records), len(parsed))
+	}
+	if parsed[2].Value != `say "hello"` || parsed[4].Value != strings.Repeat("a", 300) {
+		t.Errorf("expected TXT values to round trip, got %+v", parsed)
+	}
+}
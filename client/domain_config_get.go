@@ -0,0 +1,79 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// RecommendedIPv4 is a set of IPv4 addresses that Vercel recommends A records are created for. A
+// lower rank is preferred.
+type RecommendedIPv4 struct {
+	Rank  int      `json:"rank"`
+	Value []string `json:"value"`
+}
+
+// RecommendedCNAME is a value that Vercel recommends a CNAME record points to. A lower rank is
+// preferred.
+type RecommendedCNAME struct {
+	Rank  int    `json:"rank"`
+	Value string `json:"value"`
+}
+
+// DomainConfigResponse describes how the DNS of a domain is configured to point at Vercel.
+type DomainConfigResponse struct {
+	// ConfiguredBy is how the domain is configured (e.g. "A", "CNAME", "http" or "dns-01"), or nil
+	// if it is not configured.
+	ConfiguredBy       *string            `json:"configuredBy"`
+	AcceptedChallenges []string           `json:"acceptedChallenges"`
+	Misconfigured      bool               `json:"misconfigured"`
+	RecommendedIPv4    []RecommendedIPv4  `json:"recommendedIPv4"`
+	RecommendedCNAME   []RecommendedCNAME `json:"recommendedCNAME"`
+	TeamID             string             `json:"-"`
+}
+
+// PreferredIPv4 returns the IPv4 addresses with the lowest rank.
+func (r DomainConfigResponse) PreferredIPv4() []string {
+	if len(r.RecommendedIPv4) == 0 {
+		return nil
+	}
+	recommended := append([]RecommendedIPv4{}, r.RecommendedIPv4...)
+	sort.SliceStable(recommended, func(i, j int) bool {
+		return recommended[i].Rank < recommended[j].Rank
+	})
+	return recommended[0].Value
+}
+
+// PreferredCNAME returns the CNAME value with the lowest rank, or an empty string if there are none.
+func (r DomainConfigResponse) PreferredCNAME() string {
+	if len(r.RecommendedCNAME) == 0 {
+		return ""
+	}
+	recommended := append([]RecommendedCNAME{}, r.RecommendedCNAME...)
+	sort.SliceStable(recommended, func(i, j int) bool {
+		return recommended[i].Rank < recommended[j].Rank
+	})
+	return recommended[0].Value
+}
+
+// GetDomainConfig retrieves how the DNS of a domain is configured, and how Vercel recommends it
+// should be configured.
+func (c *Client) GetDomainConfig(ctx context.Context, domain, teamID string) (r DomainConfigResponse, err error) {
+	url := fmt.Sprintf("%s/v6/domains/%s/config", c.baseURL, domain)
+	if c.teamID(teamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.teamID(teamID))
+	}
+	tflog.Trace(c.logContext(ctx), "getting domain config", map[string]interface{}{
+		"url": url,
+	})
+	err = c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "GET",
+		url:    url,
+		body:   "",
+	}, &r)
+	r.TeamID = c.teamID(teamID)
+	return r, err
+}
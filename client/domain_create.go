@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// CreateDomainRequest defines the information needed to add a domain to an account within vercel.
+type CreateDomainRequest struct {
+	Name string `json:"name"`
+	// Method is how the domain is added. `add` adds an external domain to the account.
+	Method string `json:"method"`
+}
+
+// DomainResponse is the information Vercel exposes about a domain that has been added to an account.
+type DomainResponse struct {
+	ID                  string   `json:"id"`
+	Name                string   `json:"name"`
+	ServiceType         string   `json:"serviceType"`
+	Verified            bool     `json:"verified"`
+	Nameservers         []string `json:"nameservers"`
+	IntendedNameservers []string `json:"intendedNameservers"`
+	// VerificationRecord is the value of the TXT record that can be used to prove ownership of the
+	// domain, if it is in use by another account.
+	VerificationRecord string `json:"verificationRecord"`
+	TeamID             string `json:"-"`
+}
+
+// CreateDomain adds a domain to an account within vercel.
+func (c *Client) CreateDomain(ctx context.Context, teamID string, request CreateDomainRequest) (r DomainResponse, err error) {
+	url := fmt.Sprintf("%s/v5/domains", c.baseURL)
+	if c.teamID(teamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.teamID(teamID))
+	}
+	payload := string(mustMarshal(request))
+	tflog.Trace(c.logContext(ctx), "creating domain", map[string]interface{}{
+		"url":     url,
+		"payload": redactPayload(payload),
+	})
+	var response struct {
+		Domain DomainResponse `json:"domain"`
+	}
+	err = c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "POST",
+		url:    url,
+		body:   payload,
+	}, &response)
+	r = response.Domain
+	r.TeamID = c.teamID(teamID)
+	return r, err
+}
@@ -0,0 +1,25 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// DeleteDomain removes a domain from an account within vercel, along with its DNS records.
+func (c *Client) DeleteDomain(ctx context.Context, domain, teamID string) error {
+	url := fmt.Sprintf("%s/v6/domains/%s", c.baseURL, domain)
+	if c.teamID(teamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.teamID(teamID))
+	}
+	tflog.Trace(c.logContext(ctx), "deleting domain", map[string]interface{}{
+		"url": url,
+	})
+	return c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "DELETE",
+		url:    url,
+		body:   "",
+	}, nil)
+}
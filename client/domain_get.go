@@ -0,0 +1,31 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// GetDomain retrieves information about a domain that has been added to an account within vercel.
+func (c *Client) GetDomain(ctx context.Context, domain, teamID string) (r DomainResponse, err error) {
+	url := fmt.Sprintf("%s/v5/domains/%s", c.baseURL, domain)
+	if c.teamID(teamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.teamID(teamID))
+	}
+	tflog.Trace(c.logContext(ctx), "getting domain", map[string]interface{}{
+		"url": url,
+	})
+	var response struct {
+		Domain DomainResponse `json:"domain"`
+	}
+	err = c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "GET",
+		url:    url,
+		body:   "",
+	}, &response)
+	r = response.Domain
+	r.TeamID = c.teamID(teamID)
+	return r, err
+}
@@ -0,0 +1,100 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/vercel/terraform-provider-vercel/client"
+	"github.com/vercel/terraform-provider-vercel/client/clienttest"
+)
+
+func TestDomainLifecycle(t *testing.T) {
+	server := clienttest.NewServer()
+	defer server.Close()
+	c := server.Client()
+	ctx := context.Background()
+
+	team, err := c.CreateTeam(ctx, client.TeamCreateRequest{Slug: "domains", Name: "Domains"})
+	if err != nil {
+		t.Fatalf("unexpected error creating team: %s", err)
+	}
+
+	domain, err := c.CreateDomain(ctx, "", client.CreateDomainRequest{Name: "example.com", Method: "add"})
+	if err != nil {
+		t.Fatalf("unexpected error creating domain: %s", err)
+	}
+	if domain.Verified || domain.VerificationRecord == "" || len(domain.IntendedNameservers) == 0 {
+		t.Errorf("expected an unverified domain with verification details, got %+v", domain)
+	}
+	_, err = c.CreateDomain(ctx, team.ID, client.CreateDomainRequest{Name: "example.com", Method: "add"})
+	if !errors.Is(err, client.ErrConflict) {
+		t.Errorf("expected a conflict adding a domain that is already in use, got %v", err)
+	}
+
+	zone := false
+	err = c.UpdateDomain(ctx, "example.com", "", client.UpdateDomainRequest{Op: "update", Zone: &zone})
+	if err != nil {
+		t.Fatalf("unexpected error updating domain: %s", err)
+	}
+	server.VerifyDomain("example.com")
+	domain, err = c.GetDomain(ctx, "example.com", "")
+	if err != nil {
+		t.Fatalf("unexpected error getting domain: %s", err)
+	}
+	if domain.ServiceType != "external" || !domain.Verified {
+		t.Errorf("expected a verified domain using external DNS, got %+v", domain)
+	}
+
+	err = c.UpdateDomain(ctx, "example.com", "", client.UpdateDomainRequest{Op: "move-out", Destination: team.ID})
+	if err != nil {
+		t.Fatalf("unexpected error moving domain: %s", err)
+	}
+	if _, err = c.GetDomain(ctx, "example.com", ""); !client.NotFound(err) {
+		t.Errorf("expected the domain to no longer be in the original account, got %v", err)
+	}
+	domain, err = c.GetDomain(ctx, "example.com", team.ID)
+	if err != nil {
+		t.Fatalf("unexpected error getting moved domain: %s", err)
+	}
+	if domain.TeamID != team.ID {
+		t.Errorf("expected the domain to belong to team %s, got %s", team.ID, domain.TeamID)
+	}
+
+	if err = c.DeleteDomain(ctx, "example.com", team.ID); err != nil {
+		t.Fatalf("unexpected error deleting domain: %s", err)
+	}
+	if _, err = c.GetDomain(ctx, "example.com", team.ID); !client.NotFound(err) {
+		t.Errorf("expected the deleted domain to not be found, got %v", err)
+	}
+}
+
+func TestGetDomainConfig(t *testing.T) {
+	server := clienttest.NewServer()
+	defer server.Close()
+	c := server.Client()
+	ctx := context.Background()
+
+	config, err := c.GetDomainConfig(ctx, "www.example.com", "")
+	if err != nil {
+		t.Fatalf("unexpected error getting domain config: %s", err)
+	}
+	if !config.Misconfigured || config.ConfiguredBy != nil {
+		t.Errorf("expected the domain to be misconfigured, got %+v", config)
+	}
+	if ipv4 := config.PreferredIPv4(); len(ipv4) != 1 || ipv4[0] != "76.76.21.21" {
+		t.Errorf("expected the preferred IPv4 address to be 76.76.21.21, got %v", ipv4)
+	}
+	if cname := config.PreferredCNAME(); cname != "cname.vercel-dns.com." {
+		t.Errorf("expected the preferred CNAME to be cname.vercel-dns.com., got %s", cname)
+	}
+
+	server.ConfigureDomain("www.example.com", "CNAME")
+	config, err = c.GetDomainConfig(ctx, "www.example.com", "")
+	if err != nil {
+		t.Fatalf("unexpected error getting domain config: %s", err)
+	}
+	if config.Misconfigured || config.ConfiguredBy == nil || *config.ConfiguredBy != "CNAME" {
+		t.Errorf("expected the domain to be configured by CNAME, got %+v", config)
+	}
+}
@@ -0,0 +1,37 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// UpdateDomainRequest defines an operation that can be applied to a domain within vercel.
+// The fields that are used depend upon the operation:
+// - `update` sets whether Vercel's nameservers are intended to be used for the domain, via Zone.
+// - `move-out` moves the domain to the team or user given by Destination.
+type UpdateDomainRequest struct {
+	Op          string `json:"op"`
+	Zone        *bool  `json:"zone,omitempty"`
+	Destination string `json:"destination,omitempty"`
+}
+
+// UpdateDomain applies an operation to a domain within vercel.
+func (c *Client) UpdateDomain(ctx context.Context, domain, teamID string, request UpdateDomainRequest) error {
+	url := fmt.Sprintf("%s/v3/domains/%s", c.baseURL, domain)
+	if c.teamID(teamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.teamID(teamID))
+	}
+	payload := string(mustMarshal(request))
+	tflog.Trace(c.logContext(ctx), "updating domain", map[string]interface{}{
+		"url":     url,
+		"payload": redactPayload(payload),
+	})
+	return c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "PATCH",
+		url:    url,
+		body:   payload,
+	}, nil)
+}
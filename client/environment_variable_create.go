@@ -10,11 +10,13 @@ import (
 // CreateEnvironmentVariableRequest defines the information that needs to be passed to Vercel in order to
 // create an environment variable.
 type EnvironmentVariableRequest struct {
-	Key       string   `json:"key"`
-	Value     string   `json:"value"`
-	Target    []string `json:"target"`
-	GitBranch *string  `json:"gitBranch,omitempty"`
-	Type      string   `json:"type"`
+	Key                  string   `json:"key"`
+	Value                string   `json:"value"`
+	Target               []string `json:"target"`
+	GitBranch            *string  `json:"gitBranch,omitempty"`
+	Type                 string   `json:"type"`
+	Comment              string   `json:"comment,omitempty"`
+	CustomEnvironmentIDs []string `json:"customEnvironmentIds,omitempty"`
 }
 
 type CreateEnvironmentVariableRequest struct {
@@ -31,9 +33,9 @@ func (c *Client) CreateEnvironmentVariable(ctx context.Context, request CreateEn
 	}
 	payload := string(mustMarshal(request.EnvironmentVariable))
 
-	tflog.Trace(ctx, "creating environment variable", map[string]interface{}{
+	tflog.Trace(c.logContext(ctx), "creating environment variable", map[string]interface{}{
 		"url":     url,
-		"payload": payload,
+		"payload": redactPayload(payload),
 	})
 	err = c.doRequest(clientRequest{
 		ctx:    ctx,
@@ -59,9 +61,9 @@ func (c *Client) CreateEnvironmentVariables(ctx context.Context, request CreateE
 		url = fmt.Sprintf("%s?teamId=%s", url, c.teamID(request.TeamID))
 	}
 	payload := string(mustMarshal(request.EnvironmentVariables))
-	tflog.Trace(ctx, "creating environment variables", map[string]interface{}{
+	tflog.Trace(c.logContext(ctx), "creating environment variables", map[string]interface{}{
 		"url":     url,
-		"payload": payload,
+		"payload": redactPayload(payload),
 	})
 	return c.doRequest(clientRequest{
 		ctx:    ctx,
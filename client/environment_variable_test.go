@@ -0,0 +1,97 @@
+package client_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vercel/terraform-provider-vercel/client"
+	"github.com/vercel/terraform-provider-vercel/client/clienttest"
+)
+
+func TestEnvironmentVariableTypes(t *testing.T) {
+	server := clienttest.NewServer()
+	defer server.Close()
+	c := server.Client()
+	ctx := context.Background()
+
+	project, err := c.CreateProject(ctx, "", client.CreateProjectRequest{Name: "env-project"})
+	if err != nil {
+		t.Fatalf("unexpected error creating project: %s", err)
+	}
+
+	plain, err := c.CreateEnvironmentVariable(ctx, client.CreateEnvironmentVariableRequest{
+		EnvironmentVariable: client.EnvironmentVariableRequest{
+			Key:     "PLAIN",
+			Value:   "plain-value",
+			Target:  []string{"production"},
+			Type:    "plain",
+			Comment: "not a secret",
+		},
+		ProjectID: project.ID,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error creating plain environment variable: %s", err)
+	}
+	sensitive, err := c.CreateEnvironmentVariable(ctx, client.CreateEnvironmentVariableRequest{
+		EnvironmentVariable: client.EnvironmentVariableRequest{
+			Key:    "SENSITIVE",
+			Value:  "sensitive-value",
+			Target: []string{"production"},
+			Type:   "sensitive",
+		},
+		ProjectID: project.ID,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error creating sensitive environment variable: %s", err)
+	}
+
+	got, err := c.GetEnvironmentVariable(ctx, project.ID, "", plain.ID)
+	if err != nil {
+		t.Fatalf("unexpected error getting plain environment variable: %s", err)
+	}
+	if got.Type != "plain" || got.Value != "plain-value" || got.Comment != "not a secret" {
+		t.Errorf("unexpected plain environment variable %+v", got)
+	}
+	got, err = c.GetEnvironmentVariable(ctx, project.ID, "", sensitive.ID)
+	if err != nil {
+		t.Fatalf("unexpected error getting sensitive environment variable: %s", err)
+	}
+	if got.Type != "sensitive" || got.Value != "" {
+		t.Errorf("expected the sensitive environment variable value to be unreadable, got %+v", got)
+	}
+
+	updated, err := c.UpdateEnvironmentVariable(ctx, client.UpdateEnvironmentVariableRequest{
+		Key:       "PLAIN",
+		Value:     "plain-value",
+		Target:    []string{"production"},
+		Type:      "plain",
+		ProjectID: project.ID,
+		EnvID:     plain.ID,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error updating environment variable: %s", err)
+	}
+	if updated.Comment != "" {
+		t.Errorf("expected the comment to be removed, got %q", updated.Comment)
+	}
+	got, err = c.GetEnvironmentVariable(ctx, project.ID, "", plain.ID)
+	if err != nil {
+		t.Fatalf("unexpected error getting updated environment variable: %s", err)
+	}
+	if got.Value != "plain-value" || len(got.Target) != 1 || len(got.CustomEnvironmentIDs) != 0 {
+		t.Errorf("expected the environment variable to have no custom environments, got %+v", got)
+	}
+
+	_, err = c.CreateEnvironmentVariable(ctx, client.CreateEnvironmentVariableRequest{
+		EnvironmentVariable: client.EnvironmentVariableRequest{
+			Key:    "INVALID",
+			Value:  "value",
+			Target: []string{"production"},
+			Type:   "invalid",
+		},
+		ProjectID: project.ID,
+	})
+	if err == nil {
+		t.Errorf("expected an error creating an environment variable with an invalid type")
+	}
+}
@@ -10,14 +10,16 @@ import (
 // UpdateEnvironmentVariableRequest defines the information that needs to be passed to Vercel in order to
 // update an environment variable.
 type UpdateEnvironmentVariableRequest struct {
-	Key       string   `json:"key"`
-	Value     string   `json:"value"`
-	Target    []string `json:"target"`
-	GitBranch *string  `json:"gitBranch,omitempty"`
-	Type      string   `json:"type"`
-	ProjectID string   `json:"-"`
-	TeamID    string   `json:"-"`
-	EnvID     string   `json:"-"`
+	Key                  string   `json:"key"`
+	Value                string   `json:"value"`
+	Target               []string `json:"target"`
+	GitBranch            *string  `json:"gitBranch,omitempty"`
+	Type                 string   `json:"type"`
+	Comment              string   `json:"comment"`
+	CustomEnvironmentIDs []string `json:"customEnvironmentIds"`
+	ProjectID            string   `json:"-"`
+	TeamID               string   `json:"-"`
+	EnvID                string   `json:"-"`
 }
 
 // UpdateEnvironmentVariable will update an existing environment variable to the latest information.
@@ -26,16 +28,21 @@ func (c *Client) UpdateEnvironmentVariable(ctx context.Context, request UpdateEn
 	if c.teamID(request.TeamID) != "" {
 		url = fmt.Sprintf("%s?teamId=%s", url, c.teamID(request.TeamID))
 	}
+	// Vercel rejects a null list of custom environments, so an empty list is sent to clear them.
+	if request.CustomEnvironmentIDs == nil {
+		request.CustomEnvironmentIDs = []string{}
+	}
 	payload := string(mustMarshal(request))
-	tflog.Trace(ctx, "updating environment variable", map[string]interface{}{
+	tflog.Trace(c.logContext(ctx), "updating environment variable", map[string]interface{}{
 		"url":     url,
-		"payload": payload,
+		"payload": redactPayload(payload),
 	})
 	err = c.doRequest(clientRequest{
-		ctx:    ctx,
-		method: "PATCH",
-		url:    url,
-		body:   payload,
+		ctx:        ctx,
+		method:     "PATCH",
+		url:        url,
+		body:       payload,
+		idempotent: true,
 	}, &e)
 	// The API response returns an encrypted environment variable, but we want to return the decrypted version.
 	e.Value = request.Value
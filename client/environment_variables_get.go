@@ -16,7 +16,7 @@ func (c *Client) getEnvironmentVariables(ctx context.Context, projectID, teamID
 	envResponse := struct {
 		Env []EnvironmentVariable `json:"envs"`
 	}{}
-	tflog.Trace(ctx, "getting environment variables", map[string]interface{}{
+	tflog.Trace(c.logContext(ctx), "getting environment variables", map[string]interface{}{
 		"url": url,
 	})
 	err := c.doRequest(clientRequest{
@@ -25,8 +25,8 @@ func (c *Client) getEnvironmentVariables(ctx context.Context, projectID, teamID
 		url:    url,
 		body:   "",
 	}, &envResponse)
-	for _, env := range envResponse.Env {
-		env.TeamID = c.teamID(teamID)
+	for i := range envResponse.Env {
+		envResponse.Env[i].TeamID = c.teamID(teamID)
 	}
 	return envResponse.Env, err
 }
@@ -38,7 +38,7 @@ func (c *Client) GetEnvironmentVariable(ctx context.Context, projectID, teamID,
 		url = fmt.Sprintf("%s?teamId=%s", url, c.teamID(teamID))
 	}
 
-	tflog.Trace(ctx, "getting environment variable", map[string]interface{}{
+	tflog.Trace(c.logContext(ctx), "getting environment variable", map[string]interface{}{
 		"url": url,
 	})
 	err = c.doRequest(clientRequest{
@@ -1,9 +1,134 @@
 package client
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Sentinel errors that an APIError can be compared against with errors.Is, based on its status code.
+var (
+	// ErrUnauthorized indicates the API token is missing, invalid or has expired.
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrPaymentRequired indicates that the request exceeds the limits of the account's plan,
+	// or uses a feature that the plan does not include.
+	ErrPaymentRequired = errors.New("payment required")
+	// ErrForbidden indicates the API token does not have access to the requested resource or team.
+	ErrForbidden = errors.New("forbidden")
+	// ErrNotFound indicates the requested resource does not exist.
+	ErrNotFound = errors.New("not found")
+	// ErrConflict indicates the request conflicts with an existing resource, such as a duplicate name.
+	ErrConflict = errors.New("conflict")
+	// ErrRateLimited indicates the request was rejected because too many requests have been made.
+	ErrRateLimited = errors.New("rate limited")
+	// ErrValidation indicates that Vercel rejected the request as invalid.
+	ErrValidation = errors.New("validation failed")
+)
+
+// Is allows an APIError to be matched against the sentinel errors with errors.Is.
+func (e APIError) Is(target error) bool {
+	switch target {
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrPaymentRequired:
+		return e.StatusCode == http.StatusPaymentRequired
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrValidation:
+		return e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusUnprocessableEntity
+	}
+	return false
+}
+
+// RateLimitError is returned when Vercel rejects a request because too many requests have been made.
+type RateLimitError struct {
+	APIError
+	// RetryAfter is how long Vercel asked for the client to wait before trying again. It is zero
+	// if Vercel did not say.
+	RetryAfter time.Duration
+}
+
+// Unwrap allows the underlying APIError to be inspected with errors.As.
+func (e RateLimitError) Unwrap() error {
+	return e.APIError
+}
+
+// ValidationError is returned when Vercel rejects a request as invalid.
+type ValidationError struct {
+	APIError
+	// Field is the name of the request field that was invalid, where Vercel has reported it.
+	Field string
+}
+
+// Unwrap allows the underlying APIError to be inspected with errors.As.
+func (e ValidationError) Unwrap() error {
+	return e.APIError
+}
+
+// Error gives the ValidationError a user friendly error message, including the invalid field if known.
+func (e ValidationError) Error() string {
+	if e.Field == "" {
+		return e.APIError.Error()
+	}
+	return fmt.Sprintf("%s - %s (field: %s)", e.Code, e.Message, e.Field)
+}
+
+// validationField determines the invalid field from a validation error's JSON schema details.
+func validationField(details validationDetails) string {
+	if details.Field != "" {
+		return details.Field
+	}
+	if details.Params.AdditionalProperty != "" {
+		return details.Params.AdditionalProperty
+	}
+	if details.Params.MissingProperty != "" {
+		return details.Params.MissingProperty
+	}
+	path := details.DataPath
+	if path == "" {
+		path = details.InstancePath
+	}
+	return strings.TrimLeft(strings.ReplaceAll(path, "/", "."), ".")
+}
+
+// validationDetails are the extra properties Vercel includes in an error response when a request
+// body does not match the endpoint's schema.
+type validationDetails struct {
+	Field        string `json:"field"`
+	DataPath     string `json:"dataPath"`
+	InstancePath string `json:"instancePath"`
+	Params       struct {
+		AdditionalProperty string `json:"additionalProperty"`
+		MissingProperty    string `json:"missingProperty"`
+	} `json:"params"`
+}
+
+// typedError converts an APIError into the more specific error type for its status code, if there is one.
+func typedError(apiErr APIError, header http.Header, details validationDetails) error {
+	switch {
+	case errors.Is(apiErr, ErrRateLimited):
+		return RateLimitError{
+			APIError:   apiErr,
+			RetryAfter: parseRetryAfter(header.Get("Retry-After"), time.Now()),
+		}
+	case errors.Is(apiErr, ErrValidation):
+		return ValidationError{
+			APIError: apiErr,
+			Field:    validationField(details),
+		}
+	}
+	return apiErr
+}
 
 // NotFound detects if an error returned by the Vercel API was the result of an entity not existing.
 func NotFound(err error) bool {
-	var apiErr APIError
-	return err != nil && errors.As(err, &apiErr) && apiErr.StatusCode == 404
+	return err != nil && errors.Is(err, ErrNotFound)
 }
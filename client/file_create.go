@@ -2,9 +2,12 @@ package client
 
 import (
 	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
 	"fmt"
-	"net/http"
-	"strings"
+	"hash"
+	"io"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -13,33 +16,76 @@ import (
 type CreateFileRequest struct {
 	Filename string
 	SHA      string
-	Content  string
-	TeamID   string
+	// Content is streamed to Vercel as the body of the upload, and must contain exactly Size bytes.
+	// If Content is also an io.Seeker, it is rewound so that the upload can be retried.
+	Content io.Reader
+	Size    int64
+	TeamID  string
+}
+
+// errDigestMismatch indicates that the content of a file did not match its expected SHA.
+var errDigestMismatch = errors.New("file content does not match its SHA")
+
+// digestVerifyingReader calculates the SHA1 of the content read through it, and fails
+// the final read if this does not match the expected digest. This allows a file to be
+// verified while it is streamed to Vercel, rather than needing to read it twice.
+type digestVerifyingReader struct {
+	reader   io.Reader
+	hash     hash.Hash
+	expected string
+}
+
+func (r *digestVerifyingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.hash.Write(p[:n])
+	if errors.Is(err, io.EOF) {
+		if actual := hex.EncodeToString(r.hash.Sum(nil)); actual != r.expected {
+			return n, fmt.Errorf("%w: expected %s, got %s", errDigestMismatch, r.expected, actual)
+		}
+	}
+	return n, err
 }
 
 // CreateFile will upload a file to Vercel so that it can be later used for a Deployment.
 func (c *Client) CreateFile(ctx context.Context, request CreateFileRequest) error {
 	url := fmt.Sprintf("%s/v2/now/files", c.baseURL)
-	if request.TeamID != "" {
+	if c.teamID(request.TeamID) != "" {
 		url = fmt.Sprintf("%s?teamId=%s", url, c.teamID(request.TeamID))
 	}
-	req, err := http.NewRequestWithContext(
-		ctx,
-		"POST",
-		url,
-		strings.NewReader(request.Content),
-	)
-	if err != nil {
-		return err
-	}
 
-	req.Header.Add("x-vercel-digest", request.SHA)
-	req.Header.Set("Content-Type", "application/octet-stream")
+	seeker, seekable := request.Content.(io.Seeker)
+	attempts := 0
+	stream := func() (io.Reader, error) {
+		if attempts > 0 {
+			if _, err := seeker.Seek(0, io.SeekStart); err != nil {
+				return nil, fmt.Errorf("unable to rewind file %s: %w", request.Filename, err)
+			}
+		}
+		attempts++
+		return &digestVerifyingReader{
+			reader:   request.Content,
+			hash:     sha1.New(),
+			expected: request.SHA,
+		}, nil
+	}
 
-	tflog.Trace(ctx, "uploading file", map[string]interface{}{
-		"url": url,
-		"sha": request.SHA,
+	tflog.Trace(c.logContext(ctx), "uploading file", map[string]interface{}{
+		"url":  url,
+		"sha":  request.SHA,
+		"size": request.Size,
 	})
-	err = c._doRequest(req, nil)
-	return err
+	return c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "POST",
+		url:    url,
+		headers: map[string]string{
+			"x-vercel-digest": request.SHA,
+			"Content-Type":    "application/octet-stream",
+		},
+		stream:        stream,
+		contentLength: request.Size,
+		// Files are content addressed, so uploading the same file twice is harmless,
+		// as long as the content can be read again.
+		idempotent: seekable,
+	}, nil)
 }
@@ -0,0 +1,90 @@
+package client_test
+
+import (
+	"context"
+	"crypto/sha1"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/vercel/terraform-provider-vercel/client"
+	"github.com/vercel/terraform-provider-vercel/client/clienttest"
+)
+
+func TestCreateFileRetriesSeekableContent(t *testing.T) {
+	server := clienttest.NewServer()
+	defer server.Close()
+	c := server.Client(client.WithRetryPolicy(fastRetries))
+
+	content := "console.log('hello world')"
+	sha := fmt.Sprintf("%x", sha1.Sum([]byte(content)))
+	server.AddFault(clienttest.Fault{
+		Path:       "/v2/now/files",
+		StatusCode: http.StatusServiceUnavailable,
+		Code:       "unavailable",
+		Message:    "Service Unavailable",
+	})
+	err := c.CreateFile(context.Background(), client.CreateFileRequest{
+		Filename: "index.js",
+		SHA:      sha,
+		Content:  strings.NewReader(content),
+		Size:     int64(len(content)),
+	})
+	if err != nil {
+		t.Fatalf("expected the upload to be retried, got %s", err)
+	}
+	if !server.HasFile(sha) {
+		t.Errorf("expected file %s to have been uploaded", sha)
+	}
+}
+
+func TestCreateFileDoesNotRetryUnseekableContent(t *testing.T) {
+	server := clienttest.NewServer()
+	defer server.Close()
+	c := server.Client(client.WithRetryPolicy(fastRetries))
+
+	content := "console.log('hello world')"
+	sha := fmt.Sprintf("%x", sha1.Sum([]byte(content)))
+	server.AddFault(clienttest.Fault{
+		Path:       "/v2/now/files",
+		StatusCode: http.StatusServiceUnavailable,
+		Code:       "unavailable",
+		Message:    "Service Unavailable",
+	})
+	err := c.CreateFile(context.Background(), client.CreateFileRequest{
+		Filename: "index.js",
+		SHA:      sha,
+		// Wrapping the reader hides its Seek method.
+		Content: io.MultiReader(strings.NewReader(content)),
+		Size:    int64(len(content)),
+	})
+	if err == nil {
+		t.Fatalf("expected an upload that cannot be rewound to not be retried")
+	}
+}
+
+func TestCreateFileVerifiesDigestWhileStreaming(t *testing.T) {
+	server := clienttest.NewServer()
+	defer server.Close()
+	c := server.Client(client.WithRetryPolicy(fastRetries))
+
+	content := "console.log('hello world')"
+	sha := fmt.Sprintf("%x", sha1.Sum([]byte("something else")))
+	err := c.CreateFile(context.Background(), client.CreateFileRequest{
+		Filename: "index.js",
+		SHA:      sha,
+		Content:  strings.NewReader(content),
+		Size:     int64(len(content)),
+	})
+	if err == nil || !strings.Contains(err.Error(), "does not match") {
+		t.Fatalf("expected a digest mismatch error, got %v", err)
+	}
+	if server.HasFile(sha) {
+		t.Errorf("expected the mismatched file to not be stored")
+	}
+	if count := server.RequestCount("POST", "/v2/now/files"); count > 1 {
+		t.Errorf("expected a digest mismatch to not be retried, got %d requests", count)
+	}
+}
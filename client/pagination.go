@@ -0,0 +1,127 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// ListFilters narrows down the items returned by the List* methods. All of the filters are optional.
+type ListFilters struct {
+	// Search only returns items whose name matches the search term. It is only supported when listing projects.
+	Search string
+	// Since only returns items created (or for projects, updated) after the given time.
+	Since time.Time
+	// Until only returns items created (or for projects, updated) before the given time.
+	Until time.Time
+}
+
+// apply adds the filters to the query string of a list request.
+func (f ListFilters) apply(query url.Values) {
+	if f.Search != "" {
+		query.Set("search", f.Search)
+	}
+	if !f.Since.IsZero() {
+		query.Set("since", strconv.FormatInt(f.Since.UnixMilli(), 10))
+	}
+	if !f.Until.IsZero() {
+		query.Set("until", strconv.FormatInt(f.Until.UnixMilli(), 10))
+	}
+}
+
+// pagination is included in the response of any Vercel endpoint that returns a list of items.
+// Next is the cursor used to request the following page, and is null on the final page.
+type pagination struct {
+	Count int    `json:"count"`
+	Next  *int64 `json:"next"`
+	Prev  *int64 `json:"prev"`
+}
+
+// pageSize is the number of items requested per page. This is the largest limit allowed by the API.
+const pageSize = 100
+
+// errStopListing can be returned by the function passed to listPages to stop requesting further
+// pages, without the listing failing.
+var errStopListing = errors.New("stop listing")
+
+// listPages requests every page of a paginated list endpoint in turn, calling fn with the items
+// from each page. The items are read from the given field of the response. Listing stops early if
+// fn returns an error, which is returned unless it is errStopListing.
+//
+// Vercel uses cursor based pagination, where the `next` value of one page is passed as the
+// `until` query parameter to request the page that follows it.
+func listPages[T any](ctx context.Context, c *Client, baseURL string, query url.Values, field string, fn func([]T) error) error {
+	query.Set("limit", strconv.Itoa(pageSize))
+	var cursor int64
+	for {
+		if cursor != 0 {
+			query.Set("until", strconv.FormatInt(cursor, 10))
+		}
+		pageURL := fmt.Sprintf("%s?%s", baseURL, query.Encode())
+		tflog.Trace(c.logContext(ctx), "listing "+field, map[string]interface{}{
+			"url": pageURL,
+		})
+		var page map[string]json.RawMessage
+		err := c.doRequest(clientRequest{
+			ctx:    ctx,
+			method: "GET",
+			url:    pageURL,
+			body:   "",
+		}, &page)
+		if err != nil {
+			return err
+		}
+
+		var items []T
+		if raw, ok := page[field]; ok {
+			if err := json.Unmarshal(raw, &items); err != nil {
+				return fmt.Errorf("error unmarshaling %s: %w", field, err)
+			}
+		}
+		if err := fn(items); errors.Is(err, errStopListing) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		var p pagination
+		if raw, ok := page["pagination"]; ok {
+			if err := json.Unmarshal(raw, &p); err != nil {
+				return fmt.Errorf("error unmarshaling pagination: %w", err)
+			}
+		}
+		if p.Next == nil || len(items) == 0 {
+			return nil
+		}
+		if cursor != 0 && *p.Next >= cursor {
+			return fmt.Errorf("error listing %s: pagination did not progress past %d", field, cursor)
+		}
+		cursor = *p.Next
+	}
+}
+
+// listAll requests every page of a paginated list endpoint, returning all of the items together.
+func listAll[T any](ctx context.Context, c *Client, baseURL string, query url.Values, field string) ([]T, error) {
+	all := []T{}
+	err := listPages(ctx, c, baseURL, query, field, func(items []T) error {
+		all = append(all, items...)
+		return nil
+	})
+	return all, err
+}
+
+// listQuery creates the query string for a list request, scoped to a team if one is set.
+func (c *Client) listQuery(teamID string, filters ListFilters) url.Values {
+	query := url.Values{}
+	if c.teamID(teamID) != "" {
+		query.Set("teamId", c.teamID(teamID))
+	}
+	filters.apply(query)
+	return query
+}
@@ -0,0 +1,105 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/vercel/terraform-provider-vercel/client"
+	"github.com/vercel/terraform-provider-vercel/client/clienttest"
+)
+
+func TestListProjectsPaginates(t *testing.T) {
+	server := clienttest.NewServer()
+	defer server.Close()
+	c := server.Client()
+	ctx := context.Background()
+
+	team, err := c.CreateTeam(ctx, client.TeamCreateRequest{Slug: "paginated", Name: "Paginated"})
+	if err != nil {
+		t.Fatalf("unexpected error creating team: %s", err)
+	}
+	for i := 0; i < 250; i++ {
+		name := fmt.Sprintf("project-%03d", i)
+		if i%2 == 0 {
+			name = fmt.Sprintf("even-%03d", i)
+		}
+		_, err := c.CreateProject(ctx, team.ID, client.CreateProjectRequest{Name: name})
+		if err != nil {
+			t.Fatalf("unexpected error creating project: %s", err)
+		}
+	}
+
+	before := server.RequestCount("GET", "/v8/projects")
+	projects, err := c.ListProjects(ctx, team.ID, client.ListFilters{})
+	if err != nil {
+		t.Fatalf("unexpected error listing projects: %s", err)
+	}
+	if len(projects) != 250 {
+		t.Errorf("expected all 250 projects to be listed, got %d", len(projects))
+	}
+	seen := map[string]bool{}
+	for _, p := range projects {
+		if seen[p.ID] {
+			t.Errorf("expected project %s to only be listed once", p.ID)
+		}
+		seen[p.ID] = true
+		if p.TeamID != team.ID {
+			t.Errorf("expected project %s to have team %s, got %q", p.Name, team.ID, p.TeamID)
+		}
+	}
+	if count := server.RequestCount("GET", "/v8/projects") - before; count != 3 {
+		t.Errorf("expected 3 pages of projects to be requested, got %d", count)
+	}
+
+	projects, err = c.ListProjects(ctx, team.ID, client.ListFilters{Search: "even-"})
+	if err != nil {
+		t.Fatalf("unexpected error searching projects: %s", err)
+	}
+	if len(projects) != 125 {
+		t.Errorf("expected 125 projects to match the search, got %d", len(projects))
+	}
+}
+
+func TestListDNSRecordsAndAliases(t *testing.T) {
+	server := clienttest.NewServer()
+	defer server.Close()
+	c := server.Client()
+	ctx := context.Background()
+
+	team, err := c.CreateTeam(ctx, client.TeamCreateRequest{Slug: "records", Name: "Records"})
+	if err != nil {
+		t.Fatalf("unexpected error creating team: %s", err)
+	}
+	for i := 0; i < 120; i++ {
+		_, err := c.CreateDNSRecord(ctx, team.ID, client.CreateDNSRecordRequest{
+			Domain: "example.com",
+			Name:   fmt.Sprintf("record-%d", i),
+			Type:   "A",
+			Value:  "127.0.0.1",
+		})
+		if err != nil {
+			t.Fatalf("unexpected error creating DNS record: %s", err)
+		}
+	}
+	records, err := c.ListDNSRecords(ctx, "example.com", team.ID, client.ListFilters{})
+	if err != nil {
+		t.Fatalf("unexpected error listing DNS records: %s", err)
+	}
+	if len(records) != 120 {
+		t.Errorf("expected all 120 DNS records to be listed, got %d", len(records))
+	}
+	for _, r := range records {
+		if r.TeamID != team.ID {
+			t.Fatalf("expected DNS record %s to have team %s, got %q", r.ID, team.ID, r.TeamID)
+		}
+	}
+
+	aliases, err := c.ListAliases(ctx, team.ID, client.ListFilters{})
+	if err != nil {
+		t.Fatalf("unexpected error listing aliases: %s", err)
+	}
+	if len(aliases) != 0 {
+		t.Errorf("expected no aliases, got %d", len(aliases))
+	}
+}
@@ -17,13 +17,15 @@ type GitRepository struct {
 // EnvironmentVariable defines the information Vercel requires and surfaces about an environment variable
 // that is associated with a project.
 type EnvironmentVariable struct {
-	Key       string   `json:"key"`
-	Value     string   `json:"value"`
-	Target    []string `json:"target"`
-	GitBranch *string  `json:"gitBranch,omitempty"`
-	Type      string   `json:"type"`
-	ID        string   `json:"id,omitempty"`
-	TeamID    string   `json:"-"`
+	Key                  string   `json:"key"`
+	Value                string   `json:"value"`
+	Target               []string `json:"target"`
+	GitBranch            *string  `json:"gitBranch,omitempty"`
+	Type                 string   `json:"type"`
+	Comment              string   `json:"comment,omitempty"`
+	CustomEnvironmentIDs []string `json:"customEnvironmentIds,omitempty"`
+	ID                   string   `json:"id,omitempty"`
+	TeamID               string   `json:"-"`
 }
 
 // CreateProjectRequest defines the information necessary to create a project.
@@ -50,9 +52,9 @@ func (c *Client) CreateProject(ctx context.Context, teamID string, request Creat
 	}
 
 	payload := string(mustMarshal(request))
-	tflog.Trace(ctx, "creating project", map[string]interface{}{
+	tflog.Trace(c.logContext(ctx), "creating project", map[string]interface{}{
 		"url":     url,
-		"payload": payload,
+		"payload": redactPayload(payload),
 	})
 	err = c.doRequest(clientRequest{
 		ctx:    ctx,
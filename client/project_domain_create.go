@@ -12,10 +12,11 @@ import (
 // used to assign a domain name to any production deployments, but can also be used to configure
 // redirects, or to give specific git branches a domain name.
 type CreateProjectDomainRequest struct {
-	Name               string `json:"name"`
-	GitBranch          string `json:"gitBranch,omitempty"`
-	Redirect           string `json:"redirect,omitempty"`
-	RedirectStatusCode int64  `json:"redirectStatusCode,omitempty"`
+	Name                string `json:"name"`
+	GitBranch           string `json:"gitBranch,omitempty"`
+	Redirect            string `json:"redirect,omitempty"`
+	RedirectStatusCode  int64  `json:"redirectStatusCode,omitempty"`
+	CustomEnvironmentID string `json:"customEnvironmentId,omitempty"`
 }
 
 // CreateProjectDomain creates a project domain within Vercel.
@@ -26,9 +27,9 @@ func (c *Client) CreateProjectDomain(ctx context.Context, projectID, teamID stri
 	}
 
 	payload := string(mustMarshal(request))
-	tflog.Trace(ctx, "creating project domain", map[string]interface{}{
+	tflog.Trace(c.logContext(ctx), "creating project domain", map[string]interface{}{
 		"url":     url,
-		"payload": payload,
+		"payload": redactPayload(payload),
 	})
 	err = c.doRequest(clientRequest{
 		ctx:    ctx,
@@ -10,12 +10,25 @@ import (
 // ProjectDomainResponse defines the information that Vercel exposes about a domain that is
 // associated with a vercel project.
 type ProjectDomainResponse struct {
-	Name               string  `json:"name"`
-	ProjectID          string  `json:"projectId"`
-	TeamID             string  `json:"-"`
-	Redirect           *string `json:"redirect"`
-	RedirectStatusCode *int64  `json:"redirectStatusCode"`
-	GitBranch          *string `json:"gitBranch"`
+	Name                string  `json:"name"`
+	ProjectID           string  `json:"projectId"`
+	TeamID              string  `json:"-"`
+	Redirect            *string `json:"redirect"`
+	RedirectStatusCode  *int64  `json:"redirectStatusCode"`
+	GitBranch           *string `json:"gitBranch"`
+	CustomEnvironmentID *string `json:"customEnvironmentId"`
+	Verified            bool    `json:"verified"`
+	// Verification lists the records that must be created to verify the domain, if it is not verified.
+	Verification []DomainVerification `json:"verification"`
+}
+
+// DomainVerification is a DNS record that must be created in order to prove ownership of a domain,
+// typically because the domain is already in use by another account.
+type DomainVerification struct {
+	Type   string `json:"type"`
+	Domain string `json:"domain"`
+	Value  string `json:"value"`
+	Reason string `json:"reason"`
 }
 
 // GetProjectDomain retrieves information about a project domain from Vercel.
@@ -25,7 +38,7 @@ func (c *Client) GetProjectDomain(ctx context.Context, projectID, domain, teamID
 		url = fmt.Sprintf("%s?teamId=%s", url, c.teamID(teamID))
 	}
 
-	tflog.Trace(ctx, "getting project domain", map[string]interface{}{
+	tflog.Trace(c.logContext(ctx), "getting project domain", map[string]interface{}{
 		"url": url,
 	})
 	err = c.doRequest(clientRequest{
@@ -0,0 +1,74 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vercel/terraform-provider-vercel/client"
+	"github.com/vercel/terraform-provider-vercel/client/clienttest"
+)
+
+func TestProjectDomainVerification(t *testing.T) {
+	server := clienttest.NewServer()
+	defer server.Close()
+	c := server.Client()
+	ctx := context.Background()
+
+	// The domain belongs to another team, so using it within a project must be verified.
+	team, err := c.CreateTeam(ctx, client.TeamCreateRequest{Slug: "owner", Name: "Owner"})
+	if err != nil {
+		t.Fatalf("unexpected error creating team: %s", err)
+	}
+	_, err = c.CreateDomain(ctx, team.ID, client.CreateDomainRequest{Name: "example.com", Method: "add"})
+	if err != nil {
+		t.Fatalf("unexpected error creating domain: %s", err)
+	}
+	project, err := c.CreateProject(ctx, "", client.CreateProjectRequest{Name: "verification"})
+	if err != nil {
+		t.Fatalf("unexpected error creating project: %s", err)
+	}
+
+	domain, err := c.CreateProjectDomain(ctx, project.ID, "", client.CreateProjectDomainRequest{Name: "www.example.com"})
+	if err != nil {
+		t.Fatalf("unexpected error creating project domain: %s", err)
+	}
+	if domain.Verified || len(domain.Verification) != 1 {
+		t.Fatalf("expected the domain to need verifying, got %+v", domain)
+	}
+	challenge := domain.Verification[0]
+	if challenge.Type != "TXT" || challenge.Domain != "_vercel.example.com" || challenge.Value == "" {
+		t.Errorf("expected a TXT record challenge for _vercel.example.com, got %+v", challenge)
+	}
+
+	_, err = c.VerifyProjectDomain(ctx, project.ID, "www.example.com", "")
+	var validationErr client.ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("expected a validation error verifying the domain, got %v", err)
+	}
+	timeoutCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	_, err = c.WaitForProjectDomainVerification(timeoutCtx, project.ID, "www.example.com", "")
+	if !errors.Is(err, context.DeadlineExceeded) || !strings.Contains(err.Error(), "missing required TXT Record") {
+		t.Errorf("expected waiting for verification to time out with the reason it failed, got %v", err)
+	}
+
+	_, err = c.CreateDNSRecord(ctx, team.ID, client.CreateDNSRecordRequest{
+		Domain: "example.com",
+		Name:   "_vercel",
+		Type:   "TXT",
+		Value:  challenge.Value,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error creating TXT record: %s", err)
+	}
+	domain, err = c.WaitForProjectDomainVerification(ctx, project.ID, "www.example.com", "")
+	if err != nil {
+		t.Fatalf("unexpected error waiting for verification: %s", err)
+	}
+	if !domain.Verified || len(domain.Verification) != 0 {
+		t.Errorf("expected the domain to be verified, got %+v", domain)
+	}
+}
@@ -9,9 +9,10 @@ import (
 
 // UpdateProjectDomainRequest defines the information necessary to update a project domain.
 type UpdateProjectDomainRequest struct {
-	GitBranch          *string `json:"gitBranch"`
-	Redirect           *string `json:"redirect"`
-	RedirectStatusCode *int64  `json:"redirectStatusCode"`
+	GitBranch           *string `json:"gitBranch"`
+	Redirect            *string `json:"redirect"`
+	RedirectStatusCode  *int64  `json:"redirectStatusCode"`
+	CustomEnvironmentID *string `json:"customEnvironmentId"`
 }
 
 // UpdateProjectDomain updates an existing project domain within Vercel.
@@ -22,15 +23,16 @@ func (c *Client) UpdateProjectDomain(ctx context.Context, projectID, domain, tea
 	}
 
 	payload := string(mustMarshal(request))
-	tflog.Trace(ctx, "updating project domain", map[string]interface{}{
+	tflog.Trace(c.logContext(ctx), "updating project domain", map[string]interface{}{
 		"url":     url,
-		"payload": payload,
+		"payload": redactPayload(payload),
 	})
 	err = c.doRequest(clientRequest{
-		ctx:    ctx,
-		method: "PATCH",
-		url:    url,
-		body:   payload,
+		ctx:        ctx,
+		method:     "PATCH",
+		url:        url,
+		body:       payload,
+		idempotent: true,
 	}, &r)
 	r.TeamID = c.teamID(teamID)
 	return r, err
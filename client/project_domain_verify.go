@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// VerifyProjectDomain asks Vercel to check the verification records of a project domain. If the
+// domain cannot be verified yet, a ValidationError is returned.
+func (c *Client) VerifyProjectDomain(ctx context.Context, projectID, domain, teamID string) (r ProjectDomainResponse, err error) {
+	url := fmt.Sprintf("%s/v9/projects/%s/domains/%s/verify", c.baseURL, projectID, domain)
+	if c.teamID(teamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.teamID(teamID))
+	}
+
+	tflog.Trace(c.logContext(ctx), "verifying project domain", map[string]interface{}{
+		"url": url,
+	})
+	err = c.doRequest(clientRequest{
+		ctx:        ctx,
+		method:     "POST",
+		url:        url,
+		body:       "",
+		idempotent: true,
+	}, &r)
+	r.TeamID = c.teamID(teamID)
+	return r, err
+}
+
+// domainVerificationPollInterval is the delay between attempts to verify a project domain.
+const domainVerificationPollInterval = 10 * time.Second
+
+// WaitForProjectDomainVerification repeatedly attempts to verify a project domain until it is
+// verified, or the context is done. If the context is done first, the last error Vercel gave for
+// why the domain could not be verified is included in the returned error.
+func (c *Client) WaitForProjectDomainVerification(ctx context.Context, projectID, domain, teamID string) (ProjectDomainResponse, error) {
+	for {
+		r, err := c.VerifyProjectDomain(ctx, projectID, domain, teamID)
+		if err == nil && r.Verified {
+			return r, nil
+		}
+		if err != nil && !errors.Is(err, ErrValidation) {
+			return r, err
+		}
+		if err == nil {
+			err = errors.New("domain is not verified")
+		}
+		tflog.Debug(c.logContext(ctx), "project domain is not verified yet", map[string]interface{}{
+			"domain": domain,
+			"reason": err.Error(),
+		})
+		if sleepErr := sleep(ctx, domainVerificationPollInterval); sleepErr != nil {
+			return r, fmt.Errorf("domain %s was not verified: %w (%s)", domain, sleepErr, err)
+		}
+	}
+}
@@ -62,8 +62,17 @@ type ProtectionBypass struct {
 	Scope string `json:"scope"`
 }
 
+// ResourceConfig defines the resources used to build a project and run its serverless functions.
+// Fields that are omitted are not updated.
+type ResourceConfig struct {
+	BuildMachineType          *string `json:"buildMachineType,omitempty"`
+	FunctionDefaultMemoryType *string `json:"functionDefaultMemoryType,omitempty"`
+	FunctionDefaultTimeout    *int64  `json:"functionDefaultTimeout,omitempty"`
+}
+
 // ProjectResponse defines the information Vercel returns about a project.
 type ProjectResponse struct {
+	AutoExposeSystemEnvs        *bool                 `json:"autoExposeSystemEnvs"`
 	BuildCommand                *string               `json:"buildCommand"`
 	CommandForIgnoringBuildStep *string               `json:"commandForIgnoringBuildStep"`
 	DevCommand                  *string               `json:"devCommand"`
@@ -87,14 +96,18 @@ type ProjectResponse struct {
 		// production branch
 		ProductionBranch *string `json:"productionBranch"`
 	} `json:"link"`
-	Name                     string                      `json:"name"`
-	OutputDirectory          *string                     `json:"outputDirectory"`
-	PublicSource             *bool                       `json:"publicSource"`
-	RootDirectory            *string                     `json:"rootDirectory"`
-	ServerlessFunctionRegion *string                     `json:"serverlessFunctionRegion"`
-	SSOProtection            *Protection                 `json:"ssoProtection"`
-	PasswordProtection       *Protection                 `json:"passwordProtection"`
-	ProtectionBypass         map[string]ProtectionBypass `json:"protectionBypass"`
+	Name                            string                      `json:"name"`
+	NodeVersion                     *string                     `json:"nodeVersion"`
+	OutputDirectory                 *string                     `json:"outputDirectory"`
+	PublicSource                    *bool                       `json:"publicSource"`
+	ResourceConfig                  *ResourceConfig             `json:"resourceConfig"`
+	RootDirectory                   *string                     `json:"rootDirectory"`
+	ServerlessFunctionRegion        *string                     `json:"serverlessFunctionRegion"`
+	SkewProtectionMaxAge            *int64                      `json:"skewProtectionMaxAge"`
+	SourceFilesOutsideRootDirectory *bool                       `json:"sourceFilesOutsideRootDirectory"`
+	SSOProtection                   *Protection                 `json:"ssoProtection"`
+	PasswordProtection              *Protection                 `json:"passwordProtection"`
+	ProtectionBypass                map[string]ProtectionBypass `json:"protectionBypass"`
 }
 
 // GetProject retrieves information about an existing project from Vercel.
@@ -103,7 +116,7 @@ func (c *Client) GetProject(ctx context.Context, projectID, teamID string, shoul
 	if c.teamID(teamID) != "" {
 		url = fmt.Sprintf("%s?teamId=%s", url, c.teamID(teamID))
 	}
-	tflog.Trace(ctx, "getting project", map[string]interface{}{
+	tflog.Trace(c.logContext(ctx), "getting project", map[string]interface{}{
 		"url":                    url,
 		"shouldFetchEnvironment": shouldFetchEnvironmentVariables,
 	})
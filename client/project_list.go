@@ -3,31 +3,14 @@ package client
 import (
 	"context"
 	"fmt"
-
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-// ListProjects lists the top 100 projects (no pagination) from within Vercel.
-func (c *Client) ListProjects(ctx context.Context, teamID string) (r []ProjectResponse, err error) {
-	url := fmt.Sprintf("%s/v8/projects?limit=100", c.baseURL)
-	if c.teamID(teamID) != "" {
-		url = fmt.Sprintf("%s&teamId=%s", url, c.teamID(teamID))
-	}
-
-	pr := struct {
-		Projects []ProjectResponse `json:"projects"`
-	}{}
-	tflog.Trace(ctx, "listing projects", map[string]interface{}{
-		"url": url,
-	})
-	err = c.doRequest(clientRequest{
-		ctx:    ctx,
-		method: "GET",
-		url:    url,
-		body:   "",
-	}, &pr)
-	for _, p := range pr.Projects {
-		p.TeamID = c.teamID(teamID)
+// ListProjects lists all of the projects within Vercel that match the filters.
+func (c *Client) ListProjects(ctx context.Context, teamID string, filters ListFilters) (r []ProjectResponse, err error) {
+	url := fmt.Sprintf("%s/v8/projects", c.baseURL)
+	r, err = listAll[ProjectResponse](ctx, c, url, c.listQuery(teamID, filters), "projects")
+	for i := range r {
+		r[i].TeamID = c.teamID(teamID)
 	}
-	return pr.Projects, err
+	return r, err
 }
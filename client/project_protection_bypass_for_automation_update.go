@@ -47,15 +47,18 @@ func (c *Client) UpdateProtectionBypassForAutomation(ctx context.Context, reques
 	}
 
 	payload := getUpdateBypassProtectionRequestBody(request.NewValue, request.Secret)
-	tflog.Info(ctx, "updating protection bypass", map[string]interface{}{
+	tflog.Trace(c.logContext(ctx), "updating protection bypass", map[string]interface{}{
 		"url":      url,
-		"payload":  payload,
+		"payload":  redactPayload(payload),
 		"newValue": request.NewValue,
 	})
 	response := struct {
 		ProtectionBypass map[string]ProtectionBypass `json:"protectionBypass"`
 	}{}
-	time.Sleep(1 * time.Second)
+	err = sleep(ctx, 1*time.Second)
+	if err != nil {
+		return s, err
+	}
 	err = c.doRequest(clientRequest{
 		ctx:    ctx,
 		method: "PATCH",
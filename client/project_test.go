@@ -0,0 +1,163 @@
+package client_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vercel/terraform-provider-vercel/client"
+	"github.com/vercel/terraform-provider-vercel/client/clienttest"
+)
+
+func TestProjectLifecycle(t *testing.T) {
+	server := clienttest.NewServer()
+	defer server.Close()
+	c := server.Client()
+	ctx := context.Background()
+
+	framework := "nextjs"
+	project, err := c.CreateProject(ctx, "", client.CreateProjectRequest{
+		Name:      "test-project",
+		Framework: &framework,
+		GitRepository: &client.GitRepository{
+			Type: "github",
+			Repo: "vercel/next.js",
+		},
+		EnvironmentVariables: []client.EnvironmentVariable{
+			{
+				Key:    "FOO",
+				Value:  "bar",
+				Target: []string{"production"},
+				Type:   "encrypted",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error creating project: %s", err)
+	}
+	if project.Repository() == nil || project.Repository().Repo != "vercel/next.js" {
+		t.Errorf("expected project to be linked to vercel/next.js, got %+v", project.Repository())
+	}
+	if len(project.EnvironmentVariables) != 1 || project.EnvironmentVariables[0].Value != "bar" {
+		t.Errorf("expected a single decrypted environment variable, got %+v", project.EnvironmentVariables)
+	}
+
+	_, err = c.CreateProject(ctx, "", client.CreateProjectRequest{Name: "test-project"})
+	if err == nil {
+		t.Errorf("expected an error creating a project with a duplicate name")
+	}
+
+	updated, err := c.UpdateProject(ctx, project.ID, "", client.UpdateProjectRequest{
+		Framework:    nil,
+		BuildCommand: toPtr("npm run build"),
+	}, false)
+	if err != nil {
+		t.Fatalf("unexpected error updating project: %s", err)
+	}
+	if updated.Framework != nil {
+		t.Errorf("expected framework to be removed, got %s", *updated.Framework)
+	}
+	if updated.BuildCommand == nil || *updated.BuildCommand != "npm run build" {
+		t.Errorf("expected build command to be updated, got %v", updated.BuildCommand)
+	}
+
+	projects, err := c.ListProjects(ctx, "", client.ListFilters{})
+	if err != nil {
+		t.Fatalf("unexpected error listing projects: %s", err)
+	}
+	if len(projects) != 1 {
+		t.Errorf("expected 1 project, got %d", len(projects))
+	}
+
+	err = c.DeleteProject(ctx, project.ID, "")
+	if err != nil {
+		t.Fatalf("unexpected error deleting project: %s", err)
+	}
+	_, err = c.GetProject(ctx, project.ID, "", false)
+	if !client.NotFound(err) {
+		t.Errorf("expected project to be not found after deletion, got %v", err)
+	}
+}
+
+func TestProjectTeamScoping(t *testing.T) {
+	server := clienttest.NewServer()
+	defer server.Close()
+	c := server.Client()
+	ctx := context.Background()
+
+	team, err := c.CreateTeam(ctx, client.TeamCreateRequest{Slug: "my-team", Name: "My Team"})
+	if err != nil {
+		t.Fatalf("unexpected error creating team: %s", err)
+	}
+	project, err := c.CreateProject(ctx, team.ID, client.CreateProjectRequest{Name: "team-project"})
+	if err != nil {
+		t.Fatalf("unexpected error creating project: %s", err)
+	}
+	if project.TeamID != team.ID {
+		t.Errorf("expected project team to be %s, got %s", team.ID, project.TeamID)
+	}
+
+	_, err = c.GetProject(ctx, project.ID, "", false)
+	if !client.NotFound(err) {
+		t.Errorf("expected project to not be visible outside of its team, got %v", err)
+	}
+	_, err = c.GetProject(ctx, project.ID, "team_doesnotexist", false)
+	if err == nil {
+		t.Errorf("expected an error using an unknown team")
+	}
+}
+
+func toPtr[T any](v T) *T {
+	return &v
+}
+
+func TestProjectBuildAndRuntimeSettings(t *testing.T) {
+	server := clienttest.NewServer()
+	defer server.Close()
+	c := server.Client()
+	ctx := context.Background()
+
+	project, err := c.CreateProject(ctx, "", client.CreateProjectRequest{Name: "settings-project"})
+	if err != nil {
+		t.Fatalf("unexpected error creating project: %s", err)
+	}
+	if project.SourceFilesOutsideRootDirectory == nil || !*project.SourceFilesOutsideRootDirectory {
+		t.Errorf("expected source files outside the root directory to be included by default")
+	}
+
+	updated, err := c.UpdateProject(ctx, project.ID, "", client.UpdateProjectRequest{
+		NodeVersion:                     toPtr("18.x"),
+		SkewProtectionMaxAge:            toPtr(int64(3600)),
+		SourceFilesOutsideRootDirectory: toPtr(false),
+		ResourceConfig: &client.ResourceConfig{
+			FunctionDefaultMemoryType: toPtr("performance"),
+			FunctionDefaultTimeout:    toPtr(int64(60)),
+		},
+	}, false)
+	if err != nil {
+		t.Fatalf("unexpected error updating project: %s", err)
+	}
+	if *updated.NodeVersion != "18.x" || *updated.SkewProtectionMaxAge != 3600 || *updated.SourceFilesOutsideRootDirectory {
+		t.Errorf("expected project settings to be updated, got %+v", updated)
+	}
+	rc := updated.ResourceConfig
+	if *rc.FunctionDefaultMemoryType != "performance" || *rc.FunctionDefaultTimeout != 60 || *rc.BuildMachineType != "standard" {
+		t.Errorf("expected only the configured resources to be updated, got %+v", rc)
+	}
+
+	updated, err = c.UpdateProject(ctx, project.ID, "", client.UpdateProjectRequest{
+		SkewProtectionMaxAge: toPtr(int64(0)),
+	}, false)
+	if err != nil {
+		t.Fatalf("unexpected error disabling skew protection: %s", err)
+	}
+	if updated.SkewProtectionMaxAge != nil {
+		t.Errorf("expected skew protection to be disabled, got %d", *updated.SkewProtectionMaxAge)
+	}
+
+	_, err = c.UpdateProject(ctx, project.ID, "", client.UpdateProjectRequest{
+		ResourceConfig: &client.ResourceConfig{FunctionDefaultTimeout: toPtr(int64(1000))},
+	}, false)
+	if err == nil {
+		t.Errorf("expected an error setting a function timeout that is too long")
+	}
+}
@@ -19,18 +19,23 @@ type PasswordProtectionRequest struct {
 // - setting the field to an empty value (e.g. "") will remove the setting for that field.
 // - omitting the value entirely from the request will _not_ update the field.
 type UpdateProjectRequest struct {
-	BuildCommand                *string                    `json:"buildCommand"`
-	CommandForIgnoringBuildStep *string                    `json:"commandForIgnoringBuildStep"`
-	DevCommand                  *string                    `json:"devCommand"`
-	Framework                   *string                    `json:"framework"`
-	InstallCommand              *string                    `json:"installCommand"`
-	Name                        *string                    `json:"name,omitempty"`
-	OutputDirectory             *string                    `json:"outputDirectory"`
-	PublicSource                *bool                      `json:"publicSource"`
-	RootDirectory               *string                    `json:"rootDirectory"`
-	ServerlessFunctionRegion    *string                    `json:"serverlessFunctionRegion"`
-	SSOProtection               *Protection                `json:"ssoProtection"`
-	PasswordProtection          *PasswordProtectionRequest `json:"passwordProtection"`
+	AutoExposeSystemEnvs            *bool                      `json:"autoExposeSystemEnvs,omitempty"`
+	BuildCommand                    *string                    `json:"buildCommand"`
+	CommandForIgnoringBuildStep     *string                    `json:"commandForIgnoringBuildStep"`
+	DevCommand                      *string                    `json:"devCommand"`
+	Framework                       *string                    `json:"framework"`
+	InstallCommand                  *string                    `json:"installCommand"`
+	Name                            *string                    `json:"name,omitempty"`
+	NodeVersion                     *string                    `json:"nodeVersion,omitempty"`
+	OutputDirectory                 *string                    `json:"outputDirectory"`
+	PublicSource                    *bool                      `json:"publicSource"`
+	ResourceConfig                  *ResourceConfig            `json:"resourceConfig,omitempty"`
+	RootDirectory                   *string                    `json:"rootDirectory"`
+	ServerlessFunctionRegion        *string                    `json:"serverlessFunctionRegion"`
+	SkewProtectionMaxAge            *int64                     `json:"skewProtectionMaxAge,omitempty"`
+	SourceFilesOutsideRootDirectory *bool                      `json:"sourceFilesOutsideRootDirectory,omitempty"`
+	SSOProtection                   *Protection                `json:"ssoProtection"`
+	PasswordProtection              *PasswordProtectionRequest `json:"passwordProtection"`
 }
 
 // UpdateProject updates an existing projects configuration within Vercel.
@@ -40,16 +45,17 @@ func (c *Client) UpdateProject(ctx context.Context, projectID, teamID string, re
 		url = fmt.Sprintf("%s?teamId=%s", url, c.teamID(teamID))
 	}
 	payload := string(mustMarshal(request))
-	tflog.Trace(ctx, "updating project", map[string]interface{}{
+	tflog.Trace(c.logContext(ctx), "updating project", map[string]interface{}{
 		"url":                             url,
-		"payload":                         payload,
+		"payload":                         redactPayload(payload),
 		"shouldFetchEnvironmentVariables": shouldFetchEnvironmentVariables,
 	})
 	err = c.doRequest(clientRequest{
-		ctx:    ctx,
-		method: "PATCH",
-		url:    url,
-		body:   payload,
+		ctx:        ctx,
+		method:     "PATCH",
+		url:        url,
+		body:       payload,
+		idempotent: true,
 	}, &r)
 	if err != nil {
 		return r, err
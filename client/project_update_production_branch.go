@@ -19,15 +19,16 @@ func (c *Client) UpdateProductionBranch(ctx context.Context, request UpdateProdu
 		url = fmt.Sprintf("%s?teamId=%s", url, c.teamID(request.TeamID))
 	}
 	payload := string(mustMarshal(request))
-	tflog.Trace(ctx, "updating project production branch", map[string]interface{}{
+	tflog.Trace(c.logContext(ctx), "updating project production branch", map[string]interface{}{
 		"url":     url,
-		"payload": payload,
+		"payload": redactPayload(payload),
 	})
 	err = c.doRequest(clientRequest{
-		ctx:    ctx,
-		method: "PATCH",
-		url:    url,
-		body:   payload,
+		ctx:        ctx,
+		method:     "PATCH",
+		url:        url,
+		body:       payload,
+		idempotent: true,
 	}, &r)
 	if err != nil {
 		return r, err
@@ -0,0 +1,96 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// redacted replaces the value of any sensitive field in a logged request payload.
+const redacted = "REDACTED"
+
+// SensitiveLogFields are the names of fields whose values are secret, and so should never be logged.
+// These are masked within request payloads logged by the client, and can also be used to configure
+// masking of log fields with tflog.MaskFieldValuesWithFieldKeys.
+var SensitiveLogFields = []string{
+	"password",
+	"secret",
+	"token",
+}
+
+// redactPayload masks the values of sensitive fields within a JSON request payload, so that the
+// payload can be safely logged. This covers passwords and secrets, the values of environment
+// variables (any "value" alongside a "key"), and the environment passed to a deployment. A payload
+// that is not valid JSON is redacted entirely, as it cannot be inspected.
+func redactPayload(payload string) string {
+	if payload == "" {
+		return payload
+	}
+	var v interface{}
+	if err := json.Unmarshal([]byte(payload), &v); err != nil {
+		return redacted
+	}
+	return string(mustMarshal(redactValue(v)))
+}
+
+func redactValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		_, isEnvironmentVariable := v["key"]
+		for k, field := range v {
+			switch {
+			case isSensitiveLogField(k):
+				v[k] = redacted
+			case k == "value" && isEnvironmentVariable:
+				v[k] = redacted
+			case k == "env":
+				v[k] = redactMapValues(field)
+			default:
+				v[k] = redactValue(field)
+			}
+		}
+		return v
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+		return v
+	}
+	return v
+}
+
+// redactMapValues masks every value of a map, such as the environment variables for a deployment,
+// keeping the keys so that it is still clear which variables were sent.
+func redactMapValues(v interface{}) interface{} {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return redactValue(v)
+	}
+	for k := range m {
+		m[k] = redacted
+	}
+	return m
+}
+
+func isSensitiveLogField(name string) bool {
+	for _, f := range SensitiveLogFields {
+		if f == name {
+			return true
+		}
+	}
+	return false
+}
+
+// logContext returns a context that masks the client's API token, as well as the values of any
+// SensitiveLogFields, in logs written with it. Every log written by the client should use it, as
+// the context passed to the client is created fresh by terraform for each operation, so carries
+// no masking of its own.
+func (c *Client) logContext(ctx context.Context) context.Context {
+	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, SensitiveLogFields...)
+	if c.token == "" {
+		return ctx
+	}
+	ctx = tflog.MaskAllFieldValuesStrings(ctx, c.token)
+	return tflog.MaskMessageStrings(ctx, c.token)
+}
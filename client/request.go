@@ -3,11 +3,9 @@ package client
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io"
 	"net/http"
-	"strconv"
 	"strings"
 	"time"
 
@@ -20,7 +18,6 @@ type APIError struct {
 	Message    string `json:"message"`
 	StatusCode int
 	RawMessage []byte
-	retryAfter int
 }
 
 // Error provides a user friendly error message.
@@ -33,9 +30,34 @@ type clientRequest struct {
 	method string
 	url    string
 	body   string
+	// headers are any additional headers to send with the request.
+	headers map[string]string
+	// idempotent marks a request that is safe to retry, even though its method alone
+	// does not indicate this. See RetryPolicy for more details.
+	idempotent bool
+	// stream, if set, is used instead of body to provide a request body of contentLength bytes.
+	// It is called once for each attempt at sending the request.
+	stream        func() (io.Reader, error)
+	contentLength int64
 }
 
 func (cr *clientRequest) toHTTPRequest() (*http.Request, error) {
+	if cr.stream != nil {
+		body, err := cr.stream()
+		if err != nil {
+			return nil, err
+		}
+		r, err := http.NewRequestWithContext(cr.ctx, cr.method, cr.url, body)
+		if err != nil {
+			return nil, err
+		}
+		r.ContentLength = cr.contentLength
+		for k, v := range cr.headers {
+			r.Header.Set(k, v)
+		}
+		return r, nil
+	}
+
 	r, err := http.NewRequestWithContext(
 		cr.ctx,
 		cr.method,
@@ -48,6 +70,9 @@ func (cr *clientRequest) toHTTPRequest() (*http.Request, error) {
 	if cr.body != "" {
 		r.Header.Set("Content-Type", "application/json")
 	}
+	for k, v := range cr.headers {
+		r.Header.Set(k, v)
+	}
 	return r, nil
 }
 
@@ -55,46 +80,50 @@ func (cr *clientRequest) toHTTPRequest() (*http.Request, error) {
 // This manages:
 // - Setting the default Content-Type for requests with a body
 // - Authorization via the Bearer token
-// - Converting error responses into an inspectable type
+// - Setting the User-Agent and any additional headers configured on the client
+// - Converting error responses into inspectable types (see error.go)
 // - Unmarshaling responses
 // - Parsing a Retry-After header in the case of rate limits being hit
-// - In the case of a rate-limit being hit, trying again aftera period of time
+// - Retrying rate limited requests and transient failures, according to the RetryPolicy
 func (c *Client) doRequest(req clientRequest, v interface{}) error {
-	r, err := req.toHTTPRequest()
-	if err != nil {
-		return err
-	}
-	err = c._doRequest(r, v)
-	for retries := 0; retries < 3; retries++ {
-		var apiErr APIError
-		if errors.As(err, &apiErr) && // we received an api error
-			apiErr.StatusCode == 429 && // and it was a rate limit
-			apiErr.retryAfter > 0 && // and there was a retry time
-			apiErr.retryAfter < 5*60 { // and the retry time is less than 5 minutes
-			tflog.Error(req.ctx, "Rate limit was hit", map[string]interface{}{
-				"error":      apiErr,
-				"retryAfter": apiErr.retryAfter,
-			})
-			time.Sleep(time.Duration(apiErr.retryAfter) * time.Second)
-			r, err = req.toHTTPRequest()
-			if err != nil {
-				return err
-			}
-			err = c._doRequest(r, v)
-			if err != nil {
-				continue
-			}
-			return nil
-		} else {
-			break
+	var waited time.Duration
+	for attempt := 0; ; attempt++ {
+		r, err := req.toHTTPRequest()
+		if err != nil {
+			return err
+		}
+		err = c._doRequest(r, v)
+		if err == nil || attempt >= c.retryPolicy.MaxRetries {
+			return err
 		}
-	}
 
-	return err
+		delay := c.retryDelay(req, err, attempt)
+		if delay < 0 || waited+delay > c.retryPolicy.Budget {
+			return err
+		}
+		tflog.Warn(c.logContext(req.ctx), "Retrying request after transient error", map[string]interface{}{
+			"error":   err.Error(),
+			"method":  req.method,
+			"url":     req.url,
+			"attempt": attempt + 1,
+			"delay":   delay.String(),
+		})
+		if sleepErr := sleep(req.ctx, delay); sleepErr != nil {
+			return err
+		}
+		waited += delay
+	}
 }
 
 func (c *Client) _doRequest(req *http.Request, v interface{}) error {
-	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", c.token))
+	for name, value := range c.headers {
+		// Headers specific to the request take precedence over any configured on the client.
+		if req.Header.Get(name) == "" {
+			req.Header.Set(name, value)
+		}
+	}
+	req.Header.Set("User-Agent", c.userAgent)
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
 	resp, err := c.http().Do(req)
 	if err != nil {
 		return fmt.Errorf("error doing http request: %w", err)
@@ -110,8 +139,9 @@ func (c *Client) _doRequest(req *http.Request, v interface{}) error {
 		var errorResponse APIError
 		if string(responseBody) == "" {
 			errorResponse.StatusCode = resp.StatusCode
-			return errorResponse
+			return typedError(errorResponse, resp.Header, validationDetails{})
 		}
+		var details validationDetails
 		err = json.Unmarshal(responseBody, &struct {
 			Error *APIError `json:"error"`
 		}{
@@ -120,19 +150,15 @@ func (c *Client) _doRequest(req *http.Request, v interface{}) error {
 		if err != nil {
 			return fmt.Errorf("error unmarshaling response for status code %d: %w", resp.StatusCode, err)
 		}
+		// The details are best effort, so are only used if they can be parsed.
+		_ = json.Unmarshal(responseBody, &struct {
+			Error *validationDetails `json:"error"`
+		}{
+			Error: &details,
+		})
 		errorResponse.StatusCode = resp.StatusCode
 		errorResponse.RawMessage = responseBody
-		errorResponse.retryAfter = 1000 // set a sensible default for retrying. This is in milliseconds.
-		if resp.StatusCode == 429 {
-			retryAfterRaw := resp.Header.Get("Retry-After")
-			if retryAfterRaw != "" {
-				retryAfter, err := strconv.Atoi(retryAfterRaw)
-				if err == nil && retryAfter > 0 {
-					errorResponse.retryAfter = retryAfter
-				}
-			}
-		}
-		return errorResponse
+		return typedError(errorResponse, resp.Header, details)
 	}
 
 	if v == nil {
@@ -0,0 +1,269 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/vercel/terraform-provider-vercel/client"
+	"github.com/vercel/terraform-provider-vercel/client/clienttest"
+)
+
+func TestRequestRetriesRateLimit(t *testing.T) {
+	server := clienttest.NewServer()
+	defer server.Close()
+	c := server.Client()
+
+	server.AddFault(clienttest.Fault{
+		Method:     "POST",
+		Path:       "/v8/projects",
+		StatusCode: http.StatusTooManyRequests,
+		Code:       "rate_limited",
+		Message:    "Rate limit exceeded",
+		Header:     http.Header{"Retry-After": []string{"1"}},
+	})
+	_, err := c.CreateProject(context.Background(), "", client.CreateProjectRequest{Name: "rate-limited"})
+	if err != nil {
+		t.Fatalf("expected the request to be retried after being rate limited, got %s", err)
+	}
+	if count := server.RequestCount("POST", "/v8/projects"); count != 2 {
+		t.Errorf("expected 2 requests to create the project, got %d", count)
+	}
+}
+
+func TestRequestAPIError(t *testing.T) {
+	server := clienttest.NewServer()
+	defer server.Close()
+
+	_, err := client.New("invalid-token", client.WithBaseURL(server.URL)).GetProject(context.Background(), "prj_123", "", false)
+	var apiErr client.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected an APIError, got %v", err)
+	}
+	if apiErr.StatusCode != http.StatusForbidden || apiErr.Code != "forbidden" {
+		t.Errorf("expected a forbidden error, got %d %s", apiErr.StatusCode, apiErr.Code)
+	}
+}
+
+func TestRequestErrorTypes(t *testing.T) {
+	server := clienttest.NewServer()
+	defer server.Close()
+	c := server.Client(client.WithRetryPolicy(client.RetryPolicy{}))
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		statusCode int
+		expected   error
+	}{
+		{statusCode: http.StatusUnauthorized, expected: client.ErrUnauthorized},
+		{statusCode: http.StatusPaymentRequired, expected: client.ErrPaymentRequired},
+		{statusCode: http.StatusForbidden, expected: client.ErrForbidden},
+		{statusCode: http.StatusNotFound, expected: client.ErrNotFound},
+		{statusCode: http.StatusConflict, expected: client.ErrConflict},
+		{statusCode: http.StatusTooManyRequests, expected: client.ErrRateLimited},
+		{statusCode: http.StatusBadRequest, expected: client.ErrValidation},
+	} {
+		server.AddFault(clienttest.Fault{
+			Method:     "GET",
+			Path:       "/v8/projects",
+			StatusCode: tc.statusCode,
+			Code:       "error",
+			Message:    http.StatusText(tc.statusCode),
+		})
+		_, err := c.ListProjects(ctx, "", client.ListFilters{})
+		if !errors.Is(err, tc.expected) {
+			t.Errorf("expected a %d response to be %q, got %v", tc.statusCode, tc.expected, err)
+		}
+		var apiErr client.APIError
+		if !errors.As(err, &apiErr) || apiErr.StatusCode != tc.statusCode {
+			t.Errorf("expected an APIError with status code %d, got %v", tc.statusCode, err)
+		}
+	}
+
+	server.AddFault(clienttest.Fault{
+		Method:     "GET",
+		Path:       "/v8/projects",
+		StatusCode: http.StatusTooManyRequests,
+		Code:       "rate_limited",
+		Message:    "Rate limit exceeded",
+		Header:     http.Header{"Retry-After": []string{"30"}},
+	})
+	_, err := c.ListProjects(ctx, "", client.ListFilters{})
+	var rateLimitErr client.RateLimitError
+	if !errors.As(err, &rateLimitErr) || rateLimitErr.RetryAfter != 30*time.Second {
+		t.Errorf("expected a RateLimitError with a 30s retry after, got %v", err)
+	}
+
+	_, err = c.CreateProject(ctx, "", client.CreateProjectRequest{})
+	var validationErr client.ValidationError
+	if !errors.As(err, &validationErr) || validationErr.Field != "name" {
+		t.Errorf("expected a ValidationError for the name field, got %v", err)
+	}
+
+	_, err = c.CreateProject(ctx, "", client.CreateProjectRequest{Name: "duplicate"})
+	if err != nil {
+		t.Fatalf("unexpected error creating project: %s", err)
+	}
+	_, err = c.CreateProject(ctx, "", client.CreateProjectRequest{Name: "duplicate"})
+	if !errors.Is(err, client.ErrConflict) {
+		t.Errorf("expected creating a duplicate project to conflict, got %v", err)
+	}
+}
+
+var fastRetries = client.RetryPolicy{
+	MaxRetries: 3,
+	MinBackoff: time.Millisecond,
+	MaxBackoff: 10 * time.Millisecond,
+	Budget:     time.Second,
+}
+
+func TestRequestRetriesTransientErrors(t *testing.T) {
+	server := clienttest.NewServer()
+	defer server.Close()
+	c := server.Client(client.WithRetryPolicy(fastRetries))
+	ctx := context.Background()
+
+	project, err := c.CreateProject(ctx, "", client.CreateProjectRequest{Name: "transient"})
+	if err != nil {
+		t.Fatalf("unexpected error creating project: %s", err)
+	}
+
+	server.AddFault(clienttest.Fault{
+		Method:     "GET",
+		Path:       "/v10/projects/",
+		StatusCode: http.StatusBadGateway,
+		Code:       "bad_gateway",
+		Message:    "Bad Gateway",
+	})
+	server.AddFault(clienttest.Fault{
+		Method:     "GET",
+		Path:       "/v10/projects/",
+		Disconnect: true,
+	})
+	_, err = c.GetProject(ctx, project.ID, "", false)
+	if err != nil {
+		t.Fatalf("expected GET request to be retried, got %s", err)
+	}
+	if count := server.RequestCount("GET", "/v10/projects/"); count != 3 {
+		t.Errorf("expected 3 requests to get the project, got %d", count)
+	}
+
+	server.AddFault(clienttest.Fault{
+		Method:     "POST",
+		Path:       "/v8/projects",
+		StatusCode: http.StatusServiceUnavailable,
+		Code:       "unavailable",
+		Message:    "Service Unavailable",
+	})
+	_, err = c.CreateProject(ctx, "", client.CreateProjectRequest{Name: "not-retried"})
+	if err == nil {
+		t.Fatalf("expected creating a project to not be retried after a 5xx")
+	}
+	if count := server.RequestCount("POST", "/v8/projects"); count != 2 {
+		t.Errorf("expected 2 requests to create projects, got %d", count)
+	}
+}
+
+func TestRequestRetryLimits(t *testing.T) {
+	server := clienttest.NewServer()
+	defer server.Close()
+	c := server.Client(client.WithRetryPolicy(fastRetries))
+
+	server.AddFault(clienttest.Fault{
+		Path:       "/v10/projects/",
+		StatusCode: http.StatusInternalServerError,
+		Code:       "internal_server_error",
+		Message:    "Internal Server Error",
+		Times:      10,
+	})
+	_, err := c.GetProject(context.Background(), "prj_123", "", false)
+	var apiErr client.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusInternalServerError {
+		t.Fatalf("expected the final error to be returned, got %v", err)
+	}
+	if count := server.RequestCount("GET", "/v10/projects/"); count != fastRetries.MaxRetries+1 {
+		t.Errorf("expected %d requests, got %d", fastRetries.MaxRetries+1, count)
+	}
+
+	// A Retry-After longer than the retry budget should not be waited for.
+	server.AddFault(clienttest.Fault{
+		Path:       "/v8/projects",
+		StatusCode: http.StatusTooManyRequests,
+		Code:       "rate_limited",
+		Message:    "Rate limit exceeded",
+		Header:     http.Header{"Retry-After": []string{"60"}},
+	})
+	start := time.Now()
+	_, err = c.ListProjects(context.Background(), "", client.ListFilters{})
+	if err == nil {
+		t.Fatalf("expected the rate limit error to be returned")
+	}
+	if time.Since(start) > 5*time.Second {
+		t.Errorf("expected the request to fail without waiting for the Retry-After")
+	}
+}
+
+func TestRequestRetryRespectsContext(t *testing.T) {
+	server := clienttest.NewServer()
+	defer server.Close()
+	c := server.Client()
+
+	server.AddFault(clienttest.Fault{
+		Path:       "/v10/projects/",
+		StatusCode: http.StatusTooManyRequests,
+		Code:       "rate_limited",
+		Message:    "Rate limit exceeded",
+		Header:     http.Header{"Retry-After": []string{"30"}},
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := c.GetProject(ctx, "prj_123", "", false)
+	if err == nil {
+		t.Fatalf("expected an error when the context is cancelled while waiting to retry")
+	}
+	if time.Since(start) > 5*time.Second {
+		t.Errorf("expected the retry to be abandoned when the context was cancelled")
+	}
+}
+
+type countingTransport struct {
+	count int
+}
+
+func (t *countingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	t.count++
+	return http.DefaultTransport.RoundTrip(r)
+}
+
+func TestClientOptions(t *testing.T) {
+	server := clienttest.NewServer()
+	defer server.Close()
+
+	transport := &countingTransport{}
+	c := server.Client(
+		client.WithUserAgent("terraform-provider-vercel/1.2.3"),
+		client.WithHeaders(map[string]string{"X-Custom": "value"}),
+		client.WithTransport(transport),
+		client.WithTimeout(10*time.Second),
+	)
+	_, err := c.ListProjects(context.Background(), "", client.ListFilters{})
+	if err != nil {
+		t.Fatalf("unexpected error listing projects: %s", err)
+	}
+	if transport.count != 1 {
+		t.Errorf("expected the custom transport to be used once, got %d", transport.count)
+	}
+	requests := server.Requests()
+	if len(requests) != 1 {
+		t.Fatalf("expected 1 request, got %d", len(requests))
+	}
+	if ua := requests[0].Header.Get("User-Agent"); ua != "terraform-provider-vercel/1.2.3" {
+		t.Errorf("expected the configured User-Agent to be sent, got %q", ua)
+	}
+	if h := requests[0].Header.Get("X-Custom"); h != "value" {
+		t.Errorf("expected the configured header to be sent, got %q", h)
+	}
+}
@@ -0,0 +1,123 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how requests that fail with a transient error are retried.
+//
+// Rate limited requests are always retried, as Vercel has rejected the request without
+// processing it. Network errors and 5xx responses are only retried for requests that
+// are safe to repeat: GET, HEAD, PUT and DELETE requests, and any other request that
+// the client explicitly marks as idempotent.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of times a single request will be retried.
+	MaxRetries int
+	// MinBackoff is the initial delay before retrying a request. The delay doubles after each attempt.
+	MinBackoff time.Duration
+	// MaxBackoff is the upper bound of the delay between any two attempts.
+	MaxBackoff time.Duration
+	// Budget is the maximum total amount of time spent waiting between retries for a
+	// single request. A Retry-After that would exceed the budget is not waited for.
+	Budget time.Duration
+}
+
+// DefaultRetryPolicy is the RetryPolicy used by clients that have not been configured otherwise.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries: 5,
+	MinBackoff: 500 * time.Millisecond,
+	MaxBackoff: 30 * time.Second,
+	Budget:     5 * time.Minute,
+}
+
+// backoff calculates a jittered exponential delay for a given (zero-indexed) retry attempt.
+// Half of the delay is fixed, and half random, so that concurrent requests spread out
+// without ever retrying immediately.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	d := p.MinBackoff
+	for i := 0; i < attempt && d < p.MaxBackoff; i++ {
+		d *= 2
+	}
+	if d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	if d <= 0 {
+		return 0
+	}
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// isIdempotent determines whether a request can safely be sent more than once.
+func (cr *clientRequest) isIdempotent() bool {
+	switch cr.method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return cr.idempotent
+}
+
+// retryDelay decides whether a failed request should be retried, and if so, how long to wait
+// before doing so. A negative delay indicates that the request should not be retried.
+func (c *Client) retryDelay(req clientRequest, err error, attempt int) time.Duration {
+	// A streamed body can only be sent again if the request says it can be.
+	if req.stream != nil && !req.idempotent {
+		return -1
+	}
+	var apiErr APIError
+	if errors.As(err, &apiErr) {
+		var rateLimitErr RateLimitError
+		if errors.As(err, &rateLimitErr) {
+			if rateLimitErr.RetryAfter > 0 {
+				return rateLimitErr.RetryAfter
+			}
+			return c.retryPolicy.backoff(attempt)
+		}
+		if apiErr.StatusCode >= 500 && apiErr.StatusCode != http.StatusNotImplemented && req.isIdempotent() {
+			return c.retryPolicy.backoff(attempt)
+		}
+		return -1
+	}
+
+	// Anything else is a failure to communicate with Vercel at all, such as a connection
+	// being reset. The request may have been processed, so only retry where that is safe.
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) || errors.Is(err, errDigestMismatch) || !req.isIdempotent() {
+		return -1
+	}
+	return c.retryPolicy.backoff(attempt)
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which can be either a
+// number of seconds, or a HTTP date.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(value); err == nil && t.After(now) {
+		return t.Sub(now)
+	}
+	return 0
+}
+
+// sleep waits for the given duration, returning early with an error if the context is done first.
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -13,13 +13,15 @@ type SharedEnvironmentVariableResponse struct {
 	ID         string   `json:"id,omitempty"`
 	Value      string   `json:"value"`
 	Type       string   `json:"type"`
+	Comment    string   `json:"comment"`
 	Target     []string `json:"target"`
 	ProjectIDs []string `json:"projectId"`
 }
 
 type SharedEnvVarRequest struct {
-	Key   string `json:"key"`
-	Value string `json:"value"`
+	Key     string `json:"key"`
+	Value   string `json:"value"`
+	Comment string `json:"comment,omitempty"`
 }
 
 type SharedEnvironmentVariableRequest struct {
@@ -41,9 +43,9 @@ func (c *Client) CreateSharedEnvironmentVariable(ctx context.Context, request Cr
 		url = fmt.Sprintf("%s?teamId=%s", url, c.teamID(request.TeamID))
 	}
 	payload := string(mustMarshal(request.EnvironmentVariable))
-	tflog.Trace(ctx, "creating shared environment variable", map[string]interface{}{
+	tflog.Trace(c.logContext(ctx), "creating shared environment variable", map[string]interface{}{
 		"url":     url,
-		"payload": payload,
+		"payload": redactPayload(payload),
 	})
 	var response struct {
 		Created []SharedEnvironmentVariableResponse `json:"created"`
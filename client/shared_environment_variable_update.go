@@ -11,6 +11,7 @@ type UpdateSharedEnvironmentVariableRequest struct {
 	Key        string   `json:"key"`
 	Value      string   `json:"value"`
 	Type       string   `json:"type"`
+	Comment    string   `json:"comment"`
 	ProjectIDs []string `json:"projectId"`
 	Target     []string `json:"target"`
 	TeamID     string   `json:"-"`
@@ -30,18 +31,19 @@ func (c *Client) UpdateSharedEnvironmentVariable(ctx context.Context, request Up
 		},
 	}))
 
-	tflog.Trace(ctx, "updating shared environment variable", map[string]interface{}{
+	tflog.Trace(c.logContext(ctx), "updating shared environment variable", map[string]interface{}{
 		"url":     url,
-		"payload": payload,
+		"payload": redactPayload(payload),
 	})
 	var response struct {
 		Updated []SharedEnvironmentVariableResponse `json:"updated"`
 	}
 	err = c.doRequest(clientRequest{
-		ctx:    ctx,
-		method: "PATCH",
-		url:    url,
-		body:   payload,
+		ctx:        ctx,
+		method:     "PATCH",
+		url:        url,
+		body:       payload,
+		idempotent: true,
 	}, &response)
 	if err != nil {
 		return e, err
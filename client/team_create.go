@@ -13,9 +13,19 @@ type TeamCreateRequest struct {
 	Name string `json:"name"`
 }
 
-// TeamResponse is the information returned by the vercel api when a team is created.
+// TeamDeploymentProtection defines the deployment protection that is applied to new projects within a team.
+type TeamDeploymentProtection struct {
+	SSOProtection *Protection `json:"ssoProtection"`
+}
+
+// TeamResponse is the information returned by the vercel api about a team.
 type TeamResponse struct {
-	ID string `json:"id"`
+	ID                          string                    `json:"id"`
+	Slug                        string                    `json:"slug"`
+	Name                        string                    `json:"name"`
+	Description                 *string                   `json:"description"`
+	Avatar                      *string                   `json:"avatar"`
+	DefaultDeploymentProtection *TeamDeploymentProtection `json:"defaultDeploymentProtection"`
 }
 
 // CreateTeam creates a team within vercel.
@@ -23,9 +33,9 @@ func (c *Client) CreateTeam(ctx context.Context, request TeamCreateRequest) (r T
 	url := fmt.Sprintf("%s/v1/teams", c.baseURL)
 
 	payload := string(mustMarshal(request))
-	tflog.Trace(ctx, "creating team", map[string]interface{}{
+	tflog.Trace(c.logContext(ctx), "creating team", map[string]interface{}{
 		"url":     url,
-		"payload": payload,
+		"payload": redactPayload(payload),
 	})
 	err = c.doRequest(clientRequest{
 		ctx:    ctx,
@@ -10,7 +10,7 @@ import (
 // DeleteTeam deletes an existing team within vercel.
 func (c *Client) DeleteTeam(ctx context.Context, teamID string) error {
 	url := fmt.Sprintf("%s/v1/teams/%s", c.baseURL, teamID)
-	tflog.Trace(ctx, "deleting team", map[string]interface{}{
+	tflog.Trace(c.logContext(ctx), "deleting team", map[string]interface{}{
 		"url": url,
 	})
 	return c.doRequest(clientRequest{
@@ -0,0 +1,44 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// TeamMemberCreateRequest defines the information needed to invite a user to a team within vercel.
+// The user can be identified by either their user ID or their email address.
+type TeamMemberCreateRequest struct {
+	UserID string `json:"uid,omitempty"`
+	Email  string `json:"email,omitempty"`
+	Role   string `json:"role"`
+}
+
+// TeamMemberResponse is the information returned by the vercel api about a member of a team.
+type TeamMemberResponse struct {
+	UserID    string `json:"uid"`
+	Username  string `json:"username"`
+	Email     string `json:"email"`
+	Role      string `json:"role"`
+	Confirmed bool   `json:"confirmed"`
+	TeamID    string `json:"-"`
+}
+
+// CreateTeamMember invites a user to join a team within vercel.
+func (c *Client) CreateTeamMember(ctx context.Context, teamID string, request TeamMemberCreateRequest) (r TeamMemberResponse, err error) {
+	url := fmt.Sprintf("%s/v1/teams/%s/members", c.baseURL, c.teamID(teamID))
+	payload := string(mustMarshal(request))
+	tflog.Trace(c.logContext(ctx), "creating team member", map[string]interface{}{
+		"url":     url,
+		"payload": redactPayload(payload),
+	})
+	err = c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "POST",
+		url:    url,
+		body:   payload,
+	}, &r)
+	r.TeamID = c.teamID(teamID)
+	return r, err
+}
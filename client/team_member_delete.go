@@ -0,0 +1,22 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// DeleteTeamMember removes a member from a team within vercel.
+func (c *Client) DeleteTeamMember(ctx context.Context, teamID, userID string) error {
+	url := fmt.Sprintf("%s/v1/teams/%s/members/%s", c.baseURL, c.teamID(teamID), userID)
+	tflog.Trace(c.logContext(ctx), "deleting team member", map[string]interface{}{
+		"url": url,
+	})
+	return c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "DELETE",
+		url:    url,
+		body:   "",
+	}, nil)
+}
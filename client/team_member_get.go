@@ -0,0 +1,35 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// GetTeamMember returns information about a member of a team within vercel. Vercel does not provide
+// a way to get a single member, so the members of the team are listed until the user is found.
+func (c *Client) GetTeamMember(ctx context.Context, teamID, userID string) (r TeamMemberResponse, err error) {
+	url := fmt.Sprintf("%s/v2/teams/%s/members", c.baseURL, c.teamID(teamID))
+	found := false
+	err = listPages(ctx, c, url, c.listQuery(teamID, ListFilters{}), "members", func(members []TeamMemberResponse) error {
+		for _, m := range members {
+			if m.UserID == userID {
+				r, found = m, true
+				return errStopListing
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return r, err
+	}
+	if !found {
+		return r, APIError{
+			Code:       "not_found",
+			Message:    fmt.Sprintf("The user %s is not a member of the team", userID),
+			StatusCode: http.StatusNotFound,
+		}
+	}
+	r.TeamID = c.teamID(teamID)
+	return r, nil
+}
@@ -0,0 +1,30 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// TeamMemberUpdateRequest defines the information that can be updated for a member of a team.
+type TeamMemberUpdateRequest struct {
+	Role string `json:"role"`
+}
+
+// UpdateTeamMember updates the role of an existing member of a team within vercel.
+func (c *Client) UpdateTeamMember(ctx context.Context, teamID, userID string, request TeamMemberUpdateRequest) error {
+	url := fmt.Sprintf("%s/v1/teams/%s/members/%s", c.baseURL, c.teamID(teamID), userID)
+	payload := string(mustMarshal(request))
+	tflog.Trace(c.logContext(ctx), "updating team member", map[string]interface{}{
+		"url":     url,
+		"payload": redactPayload(payload),
+	})
+	return c.doRequest(clientRequest{
+		ctx:        ctx,
+		method:     "PATCH",
+		url:        url,
+		body:       payload,
+		idempotent: true,
+	}, nil)
+}
@@ -0,0 +1,149 @@
+package client_test
+
+import (
+	"context"
+	"crypto/sha1"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/vercel/terraform-provider-vercel/client"
+	"github.com/vercel/terraform-provider-vercel/client/clienttest"
+)
+
+func TestUpdateTeam(t *testing.T) {
+	server := clienttest.NewServer()
+	defer server.Close()
+	c := server.Client()
+	ctx := context.Background()
+
+	team, err := c.CreateTeam(ctx, client.TeamCreateRequest{Slug: "my-team", Name: "My Team"})
+	if err != nil {
+		t.Fatalf("unexpected error creating team: %s", err)
+	}
+	if _, err := c.CreateTeam(ctx, client.TeamCreateRequest{Slug: "taken"}); err != nil {
+		t.Fatalf("unexpected error creating team: %s", err)
+	}
+
+	avatar := "not really an image"
+	sha := fmt.Sprintf("%x", sha1.Sum([]byte(avatar)))
+	err = c.CreateFile(ctx, client.CreateFileRequest{
+		Filename: "avatar.png",
+		SHA:      sha,
+		Content:  strings.NewReader(avatar),
+		Size:     int64(len(avatar)),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error uploading avatar: %s", err)
+	}
+
+	updated, err := c.UpdateTeam(ctx, team.ID, client.TeamUpdateRequest{
+		Slug:        toPtr("renamed-team"),
+		Description: toPtr("A team for testing"),
+		Avatar:      toPtr(sha),
+		DefaultDeploymentProtection: &client.TeamDeploymentProtection{
+			SSOProtection: &client.Protection{DeploymentType: "all"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error updating team: %s", err)
+	}
+	if updated.Slug != "renamed-team" || updated.Name != "My Team" {
+		t.Errorf("expected only the slug to be renamed, got %s (%s)", updated.Slug, updated.Name)
+	}
+
+	got, err := c.GetTeam(ctx, "renamed-team")
+	if err != nil {
+		t.Fatalf("unexpected error getting team by slug: %s", err)
+	}
+	if got.ID != team.ID {
+		t.Errorf("expected team %s, got %s", team.ID, got.ID)
+	}
+	if got.Description == nil || *got.Description != "A team for testing" {
+		t.Errorf("expected the description to be updated, got %v", got.Description)
+	}
+	if got.Avatar == nil || *got.Avatar != sha {
+		t.Errorf("expected the avatar to be %s, got %v", sha, got.Avatar)
+	}
+	if got.DefaultDeploymentProtection == nil || got.DefaultDeploymentProtection.SSOProtection == nil ||
+		got.DefaultDeploymentProtection.SSOProtection.DeploymentType != "all" {
+		t.Errorf("expected production deployments to be protected by default, got %+v", got.DefaultDeploymentProtection)
+	}
+
+	_, err = c.UpdateTeam(ctx, team.ID, client.TeamUpdateRequest{Slug: toPtr("taken")})
+	if !errors.Is(err, client.ErrConflict) {
+		t.Errorf("expected a conflict renaming the team to an existing slug, got %v", err)
+	}
+}
+
+func TestTeamMembers(t *testing.T) {
+	server := clienttest.NewServer()
+	defer server.Close()
+	c := server.Client()
+	ctx := context.Background()
+
+	team, err := c.CreateTeam(ctx, client.TeamCreateRequest{Slug: "members", Name: "Members"})
+	if err != nil {
+		t.Fatalf("unexpected error creating team: %s", err)
+	}
+
+	invited, err := c.CreateTeamMember(ctx, team.ID, client.TeamMemberCreateRequest{
+		Email: "engineer@example.com",
+		Role:  "DEVELOPER",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error inviting team member: %s", err)
+	}
+	if invited.UserID == "" || invited.TeamID != team.ID {
+		t.Fatalf("expected the invited member to have a user ID within the team, got %+v", invited)
+	}
+	_, err = c.CreateTeamMember(ctx, team.ID, client.TeamMemberCreateRequest{
+		Email: "engineer@example.com",
+		Role:  "MEMBER",
+	})
+	if !errors.Is(err, client.ErrConflict) {
+		t.Errorf("expected a conflict inviting an existing member, got %v", err)
+	}
+
+	// Enough members are added that they cannot all be listed in a single page.
+	for i := 0; i < 120; i++ {
+		_, err := c.CreateTeamMember(ctx, team.ID, client.TeamMemberCreateRequest{
+			UserID: fmt.Sprintf("usr_%03d", i),
+			Role:   "VIEWER",
+		})
+		if err != nil {
+			t.Fatalf("unexpected error adding team member: %s", err)
+		}
+	}
+	// Invitations that have not yet been accepted are still found, so they are not invited again.
+	pending, err := c.GetTeamMember(ctx, team.ID, invited.UserID)
+	if err != nil {
+		t.Fatalf("unexpected error getting invited team member: %s", err)
+	}
+	if pending.Confirmed || pending.Email != "engineer@example.com" || pending.Role != "DEVELOPER" {
+		t.Errorf("expected a pending DEVELOPER invitation for engineer@example.com, got %+v", pending)
+	}
+	server.ConfirmTeamMember(team.ID, invited.UserID)
+
+	err = c.UpdateTeamMember(ctx, team.ID, invited.UserID, client.TeamMemberUpdateRequest{Role: "OWNER"})
+	if err != nil {
+		t.Fatalf("unexpected error updating team member: %s", err)
+	}
+	member, err := c.GetTeamMember(ctx, team.ID, invited.UserID)
+	if err != nil {
+		t.Fatalf("unexpected error getting team member: %s", err)
+	}
+	if member.Role != "OWNER" || !member.Confirmed || member.Email != "engineer@example.com" {
+		t.Errorf("expected a confirmed OWNER engineer@example.com, got %+v", member)
+	}
+
+	err = c.DeleteTeamMember(ctx, team.ID, invited.UserID)
+	if err != nil {
+		t.Fatalf("unexpected error deleting team member: %s", err)
+	}
+	_, err = c.GetTeamMember(ctx, team.ID, invited.UserID)
+	if !client.NotFound(err) {
+		t.Errorf("expected the removed member to not be found, got %v", err)
+	}
+}
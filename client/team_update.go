@@ -0,0 +1,36 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// TeamUpdateRequest defines the information that can be updated within a vercel team.
+// Fields that are omitted are not updated.
+type TeamUpdateRequest struct {
+	Slug                        *string                   `json:"slug,omitempty"`
+	Name                        *string                   `json:"name,omitempty"`
+	Description                 *string                   `json:"description,omitempty"`
+	Avatar                      *string                   `json:"avatar,omitempty"`
+	DefaultDeploymentProtection *TeamDeploymentProtection `json:"defaultDeploymentProtection,omitempty"`
+}
+
+// UpdateTeam updates an existing team within vercel.
+func (c *Client) UpdateTeam(ctx context.Context, teamID string, request TeamUpdateRequest) (r TeamResponse, err error) {
+	url := fmt.Sprintf("%s/v2/teams/%s", c.baseURL, teamID)
+	payload := string(mustMarshal(request))
+	tflog.Trace(c.logContext(ctx), "updating team", map[string]interface{}{
+		"url":     url,
+		"payload": redactPayload(payload),
+	})
+	err = c.doRequest(clientRequest{
+		ctx:        ctx,
+		method:     "PATCH",
+		url:        url,
+		body:       payload,
+		idempotent: true,
+	}, &r)
+	return r, err
+}
@@ -0,0 +1,12 @@
+data "vercel_deployment" "example" {
+  id = "dpl_2Lp9yRw1Z1uZ8j8rXbLz3X7b2QwK"
+}
+
+# Deployments can also be looked up by their URL
+data "vercel_deployment" "preview" {
+  url = "my-project-abc123.vercel.app"
+}
+
+output "preview_branch" {
+  value = data.vercel_deployment.preview.ref
+}
@@ -0,0 +1,17 @@
+data "vercel_project" "example" {
+  name = "my-existing-project"
+}
+
+# Find the latest ready preview deployment of the `staging` branch
+data "vercel_deployments" "staging" {
+  project_id = data.vercel_project.example.id
+  target     = "preview"
+  state      = "READY"
+  branch     = "staging"
+  limit      = 1
+}
+
+resource "vercel_alias" "staging" {
+  alias         = "my-project-staging.vercel.app"
+  deployment_id = data.vercel_deployments.staging.deployments[0].id
+}
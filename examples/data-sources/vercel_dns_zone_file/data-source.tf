@@ -0,0 +1,9 @@
+data "vercel_dns_zone_file" "example" {
+  domain = "example.com"
+}
+
+# Write the records of the domain to a local zone file.
+resource "local_file" "zone" {
+  filename = "${path.module}/example.com.zone"
+  content  = data.vercel_dns_zone_file.example.content
+}
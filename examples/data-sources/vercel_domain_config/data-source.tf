@@ -0,0 +1,24 @@
+data "vercel_domain_config" "apex" {
+  domain = "example.com"
+}
+
+data "vercel_domain_config" "www" {
+  domain = "www.example.com"
+}
+
+# Point domains managed by another DNS provider at Vercel.
+resource "aws_route53_record" "apex" {
+  zone_id = "Z0123456789ABCDEFGHIJ"
+  name    = "example.com"
+  type    = "A"
+  ttl     = 300
+  records = data.vercel_domain_config.apex.recommended_ipv4
+}
+
+resource "aws_route53_record" "www" {
+  zone_id = "Z0123456789ABCDEFGHIJ"
+  name    = "www.example.com"
+  type    = "CNAME"
+  ttl     = 300
+  records = [data.vercel_domain_config.www.recommended_cname]
+}
@@ -0,0 +1,13 @@
+data "vercel_projects" "nextjs" {
+  framework = "nextjs"
+}
+
+# Add an environment variable to every Next.js project
+resource "vercel_project_environment_variable" "example" {
+  for_each = { for p in data.vercel_projects.nextjs.projects : p.id => p }
+
+  project_id = each.key
+  key        = "NEXT_TELEMETRY_DISABLED"
+  value      = "1"
+  target     = ["production", "preview"]
+}
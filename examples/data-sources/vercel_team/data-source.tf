@@ -0,0 +1,8 @@
+data "vercel_team" "example" {
+  slug = "my-team"
+}
+
+resource "vercel_project" "example" {
+  name    = "example-project"
+  team_id = data.vercel_team.example.id
+}
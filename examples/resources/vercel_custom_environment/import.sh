@@ -0,0 +1,9 @@
+# If importing into a personal account, or with a team configured on
+# the provider, simply use the project ID and the custom environment ID or slug.
+# - project_id can be found in the project `settings` tab in the Vercel UI.
+terraform import vercel_custom_environment.example prj_xxxxxxxxxxxxxxxxxxxxxxxxxxxx/staging
+
+# Alternatively, you can import via the team_id, project_id and custom environment ID or slug.
+# - team_id can be found in the team `settings` tab in the Vercel UI.
+# - project_id can be found in the project `settings` tab in the Vercel UI.
+terraform import vercel_custom_environment.example team_xxxxxxxxxxxxxxxxxxxxxxxx/prj_xxxxxxxxxxxxxxxxxxxxxxxxxxxx/env_xxxxxxxxxxxxxxxxxxxxxxxxxxxx
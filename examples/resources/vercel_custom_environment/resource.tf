@@ -0,0 +1,32 @@
+resource "vercel_project" "example" {
+  name = "example-project"
+}
+
+# A staging environment that git branches starting with
+# `release/` are automatically deployed to.
+resource "vercel_custom_environment" "example" {
+  project_id  = vercel_project.example.id
+  name        = "staging"
+  description = "A place to test changes before they reach production"
+
+  branch_tracking = {
+    pattern = "release/"
+    type    = "startsWith"
+  }
+}
+
+# Domains and Environment Variables can be assigned to
+# the Custom Environment.
+resource "vercel_project_domain" "example" {
+  project_id            = vercel_project.example.id
+  domain                = "staging.example.com"
+  custom_environment_id = vercel_custom_environment.example.id
+}
+
+resource "vercel_project_environment_variable" "example" {
+  project_id             = vercel_project.example.id
+  key                    = "API_URL"
+  value                  = "https://api.staging.example.com"
+  target                 = []
+  custom_environment_ids = [vercel_custom_environment.example.id]
+}
@@ -45,6 +45,18 @@ resource "vercel_deployment" "git_example" {
   ref        = "d92f10e" # or a git branch
 }
 
+## Or deploying to a custom environment
+resource "vercel_custom_environment" "staging" {
+  project_id = vercel_project.git_example.id
+  name       = "staging"
+}
+
+resource "vercel_deployment" "custom_environment_example" {
+  project_id         = vercel_project.git_example.id
+  ref                = "release/1.0"
+  custom_environment = vercel_custom_environment.staging.id
+}
+
 ## Or deploying a prebuilt project 
 data "vercel_project" "prebuilt_example" {
   name = "my-prebuilt-project"
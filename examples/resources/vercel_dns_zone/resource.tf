@@ -0,0 +1,47 @@
+resource "vercel_dns_zone" "example" {
+  domain = "example.com"
+
+  # Delete any records that are not listed below, apart from
+  # those created automatically by Vercel.
+  delete_unmanaged = true
+  exclude = {
+    system = true
+  }
+
+  records = [
+    {
+      name  = "www"
+      type  = "CNAME"
+      value = "cname.vercel-dns.com."
+    },
+    {
+      name        = ""
+      type        = "MX"
+      value       = "mail.example.com."
+      mx_priority = 10
+      ttl         = 3600
+    },
+    {
+      name = "_sip._tcp"
+      type = "SRV"
+      srv = {
+        port     = 5060
+        weight   = 10
+        priority = 10
+        target   = "sip.example.com."
+      }
+    },
+    {
+      name  = ""
+      type  = "TXT"
+      value = "v=spf1 include:_spf.example.com ~all"
+    },
+  ]
+}
+
+# The records can also be read from a BIND zone file, for example
+# one exported from another DNS provider.
+resource "vercel_dns_zone" "from_zone_file" {
+  domain    = "example.org"
+  zone_file = file("${path.module}/example.org.zone")
+}
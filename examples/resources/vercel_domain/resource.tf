@@ -0,0 +1,23 @@
+# A domain whose DNS is managed by Vercel. The domain's nameservers
+# should be updated to the `intended_nameservers` at the registrar.
+resource "vercel_domain" "example" {
+  name = "example.com"
+}
+
+output "nameservers" {
+  value = vercel_domain.example.intended_nameservers
+}
+
+# A domain whose DNS is managed elsewhere, and is pointed at Vercel
+# with a CNAME record.
+resource "vercel_domain" "external" {
+  name   = "example.org"
+  method = "cname"
+}
+
+resource "vercel_dns_record" "example" {
+  domain = vercel_domain.example.name
+  name   = "www"
+  type   = "CNAME"
+  value  = "cname.vercel-dns.com."
+}
@@ -7,3 +7,8 @@ terraform import vercel_project.example prj_xxxxxxxxxxxxxxxxxxxxxxxxxxxx
 # - team_id can be found in the team `settings` tab in the Vercel UI.
 # - project_id can be found in the project `settings` tab in the Vercel UI.
 terraform import vercel_project.example team_xxxxxxxxxxxxxxxxxxxxxxxx/prj_xxxxxxxxxxxxxxxxxxxxxxxxxxxx
+
+# Environment variables are not imported by default. To import them too, add
+# `#environment` to the end of the ID. The values of sensitive environment
+# variables cannot be read, so they must be set in the configuration.
+terraform import vercel_project.example "prj_xxxxxxxxxxxxxxxxxxxxxxxxxxxx#environment"
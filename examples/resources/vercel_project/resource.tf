@@ -18,3 +18,19 @@ resource "vercel_project" "example" {
   name      = "example-project"
   framework = "nextjs"
 }
+
+# A project in a monorepo, with its build and runtime
+# settings managed by terraform.
+resource "vercel_project" "with_settings" {
+  name           = "example-project-with-settings"
+  framework      = "nextjs"
+  root_directory = "apps/web"
+
+  node_version                        = "20.x"
+  build_machine_type                  = "enhanced"
+  function_default_memory_type        = "performance"
+  function_default_timeout            = 60
+  skew_protection_max_age             = 86400
+  auto_expose_system_envs             = true
+  source_files_outside_root_directory = true
+}
@@ -18,3 +18,19 @@ resource "vercel_project_domain" "example_redirect" {
   redirect             = vercel_project_domain.example.domain
   redirect_status_code = 307
 }
+
+# A domain that is already in use by another Vercel account must be
+# verified. Terraform can wait for the required records to be created.
+resource "vercel_project_domain" "example_verified" {
+  project_id            = vercel_project.example.id
+  domain                = "www.example.com"
+  wait_for_verification = true
+
+  timeouts {
+    create = "30m"
+  }
+}
+
+output "verification_records" {
+  value = vercel_project_domain.example_verified.verification
+}
@@ -25,3 +25,14 @@ resource "vercel_project_environment_variable" "example_git_branch" {
   target     = ["preview"]
   git_branch = "staging"
 }
+
+# A sensitive environment variable. Its value cannot be read back
+# from Vercel once it has been created.
+resource "vercel_project_environment_variable" "example_sensitive" {
+  project_id = vercel_project.example.id
+  key        = "secret"
+  value      = "some-secret-value"
+  target     = ["production"]
+  type       = "sensitive"
+  comment    = "The secret used to sign production sessions"
+}
@@ -0,0 +1,6 @@
+# Teams can be imported by their ID.
+# - team_id can be found in the team `settings` tab in the Vercel UI.
+terraform import vercel_team.example team_xxxxxxxxxxxxxxxxxxxxxxxx
+
+# Alternatively, you can import a team via its slug.
+terraform import vercel_team.example my-team
@@ -0,0 +1,15 @@
+resource "vercel_team" "example" {
+  slug        = "my-team"
+  name        = "My Team"
+  description = "The team that builds our marketing sites"
+  avatar      = "${path.module}/avatar.png"
+
+  default_vercel_authentication = {
+    protect_production = false
+  }
+}
+
+resource "vercel_project" "example" {
+  name    = "example-project"
+  team_id = vercel_team.example.id
+}
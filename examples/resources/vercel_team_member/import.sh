@@ -0,0 +1,4 @@
+# Team members can be imported via the team_id and the user_id of the member.
+# - team_id can be found in the team `settings` tab in the Vercel UI.
+# - user_id can be found in the account `settings` tab of the member in the Vercel UI.
+terraform import vercel_team_member.example team_xxxxxxxxxxxxxxxxxxxxxxxx/xxxxxxxxxxxxxxxxxxxxxxxx
@@ -0,0 +1,15 @@
+data "vercel_team" "example" {
+  slug = "my-team"
+}
+
+resource "vercel_team_member" "by_email" {
+  team_id = data.vercel_team.example.id
+  email   = "engineer@example.com"
+  role    = "DEVELOPER"
+}
+
+resource "vercel_team_member" "by_user_id" {
+  team_id = data.vercel_team.example.id
+  user_id = "xxxxxxxxxxxxxxxxxxxxxxxx"
+  role    = "VIEWER"
+}
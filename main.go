@@ -8,8 +8,11 @@ import (
 	"github.com/vercel/terraform-provider-vercel/vercel"
 )
 
+// version is set at build time by goreleaser.
+var version = "dev"
+
 func main() {
-	err := providerserver.Serve(context.Background(), vercel.New, providerserver.ServeOpts{
+	err := providerserver.Serve(context.Background(), vercel.New(version), providerserver.ServeOpts{
 		Address: "registry.terraform.io/vercel/vercel",
 	})
 	if err != nil {
@@ -42,7 +42,7 @@ func main() {
 }
 
 func deleteAllDNSRecords(ctx context.Context, c *client.Client, domain, teamID string) error {
-	dnsRecords, err := c.ListDNSRecords(ctx, domain, teamID)
+	dnsRecords, err := c.ListDNSRecords(ctx, domain, teamID, client.ListFilters{})
 	if err != nil {
 		return fmt.Errorf("error listing dns records: %w", err)
 	}
@@ -62,7 +62,7 @@ func deleteAllDNSRecords(ctx context.Context, c *client.Client, domain, teamID s
 }
 
 func deleteAllProjects(ctx context.Context, c *client.Client, teamID string) error {
-	projects, err := c.ListProjects(ctx, teamID)
+	projects, err := c.ListProjects(ctx, teamID, client.ListFilters{Search: "test-acc"})
 	if err != nil {
 		return fmt.Errorf("error listing projects: %w", err)
 	}
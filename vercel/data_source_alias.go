@@ -91,7 +91,7 @@ func (d *aliasDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 			fmt.Sprintf("Could not read alias %s %s, unexpected error: %s",
 				config.TeamID.ValueString(),
 				config.Alias.ValueString(),
-				err,
+				describeError(err),
 			),
 		)
 		return
@@ -0,0 +1,185 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &deploymentDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &deploymentDataSource{}
+)
+
+func newDeploymentDataSource() datasource.DataSource {
+	return &deploymentDataSource{}
+}
+
+type deploymentDataSource struct {
+	client *client.Client
+}
+
+func (d *deploymentDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment"
+}
+
+func (d *deploymentDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Schema returns the schema information for a deployment data source
+func (d *deploymentDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides information about an existing deployment within Vercel.
+
+The deployment can be looked up either by its ID, or by its URL.
+`,
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The team ID the deployment exists under. Required when configuring a team resource if a default team has not been set in the provider.",
+			},
+			"id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the deployment. Exactly one of `id` or `url` must be specified.",
+			},
+			"url": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The unique URL of the deployment, such as `my-project-abc123.vercel.app`. Exactly one of `id` or `url` must be specified.",
+			},
+			"project_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the project the deployment belongs to.",
+			},
+			"target": schema.StringAttribute{
+				Computed:    true,
+				Description: "The target of the deployment. Either `production` or `preview`.",
+			},
+			"aliases": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The domains and aliases that are assigned to the deployment.",
+			},
+			"ref": schema.StringAttribute{
+				Computed:    true,
+				Description: "The git branch the deployment was built from, if it was created from a git repository.",
+			},
+			"sha": schema.StringAttribute{
+				Computed:    true,
+				Description: "The git commit SHA the deployment was built from, if it was created from a git repository.",
+			},
+			"repository": schema.StringAttribute{
+				Computed:    true,
+				Description: "The git repository the deployment was built from, in the format `owner/name`, if it was created from a git repository.",
+			},
+			"ready_state": schema.StringAttribute{
+				Computed:    true,
+				Description: "The state of the deployment. One of `BUILDING`, `ERROR`, `INITIALIZING`, `QUEUED`, `READY` or `CANCELED`.",
+			},
+			"creator": schema.StringAttribute{
+				Computed:    true,
+				Description: "The username of the user or team that created the deployment.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the deployment was created, in RFC 3339 format.",
+			},
+		},
+	}
+}
+
+// ValidateConfig validates the Terraform config for a deployment data source.
+func (d *deploymentDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config DeploymentDataSource
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.ID.IsNull() && !config.URL.IsNull() {
+		resp.Diagnostics.AddError(
+			"Deployment Invalid",
+			"A Deployment cannot have both `id` and `url` specified",
+		)
+		return
+	}
+	if config.ID.IsNull() && config.URL.IsNull() {
+		resp.Diagnostics.AddError(
+			"Deployment Invalid",
+			"A Deployment must have either `id` or `url` specified",
+		)
+		return
+	}
+}
+
+// Read will read the deployment information by requesting it from the Vercel API, and will update terraform
+// with this information.
+// It is called by the provider whenever data source values should be read to update state.
+func (d *deploymentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config DeploymentDataSource
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The API accepts either an ID or a hostname, so strip any scheme that has been included in a URL.
+	idOrURL := config.ID.ValueString()
+	if idOrURL == "" {
+		idOrURL = strings.TrimSuffix(strings.TrimPrefix(config.URL.ValueString(), "https://"), "/")
+	}
+	out, err := d.client.GetDeployment(ctx, idOrURL, config.TeamID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading deployment",
+			fmt.Sprintf("Could not read deployment %s %s, unexpected error: %s",
+				config.TeamID.ValueString(),
+				idOrURL,
+				describeError(err),
+			),
+		)
+		return
+	}
+
+	result := convertResponseToDeploymentDataSource(out)
+	if !config.URL.IsNull() {
+		// Keep the URL as it was configured, so that a scheme or trailing slash doesn't cause an inconsistent result.
+		result.URL = config.URL
+	}
+	tflog.Trace(ctx, "read deployment", map[string]interface{}{
+		"team_id":       result.TeamID.ValueString(),
+		"deployment_id": result.ID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
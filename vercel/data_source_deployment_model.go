@@ -0,0 +1,49 @@
+package vercel
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+// DeploymentDataSource reflects the state terraform stores internally for a deployment data source.
+type DeploymentDataSource struct {
+	TeamID     types.String   `tfsdk:"team_id"`
+	ID         types.String   `tfsdk:"id"`
+	URL        types.String   `tfsdk:"url"`
+	ProjectID  types.String   `tfsdk:"project_id"`
+	Target     types.String   `tfsdk:"target"`
+	Aliases    []types.String `tfsdk:"aliases"`
+	Ref        types.String   `tfsdk:"ref"`
+	SHA        types.String   `tfsdk:"sha"`
+	Repository types.String   `tfsdk:"repository"`
+	ReadyState types.String   `tfsdk:"ready_state"`
+	Creator    types.String   `tfsdk:"creator"`
+	CreatedAt  types.String   `tfsdk:"created_at"`
+}
+
+func convertResponseToDeploymentDataSource(response client.DeploymentResponse) DeploymentDataSource {
+	target := types.StringValue("preview")
+	if response.Target != nil && *response.Target != "" {
+		target = types.StringValue(*response.Target)
+	}
+	aliases := []types.String{}
+	for _, a := range response.Aliases {
+		aliases = append(aliases, types.StringValue(a))
+	}
+	return DeploymentDataSource{
+		TeamID:     toTeamID(response.TeamID),
+		ID:         types.StringValue(response.ID),
+		URL:        types.StringValue(response.URL),
+		ProjectID:  types.StringValue(response.ProjectID),
+		Target:     target,
+		Aliases:    aliases,
+		Ref:        fromOptionalString(response.GitRef()),
+		SHA:        fromOptionalString(response.GitSHA()),
+		Repository: fromOptionalString(response.GitRepository()),
+		ReadyState: types.StringValue(response.ReadyState),
+		Creator:    fromOptionalString(response.Creator.Username),
+		CreatedAt:  types.StringValue(time.UnixMilli(response.CreatedAt).UTC().Format(time.RFC3339)),
+	}
+}
@@ -0,0 +1,49 @@
+package vercel_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_DeploymentDataSource(t *testing.T) {
+	projectSuffix := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		CheckDestroy:             noopDestroyCheck,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentDataSourceConfig(projectSuffix, teamIDConfig()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.vercel_deployment.by_id", "url", "vercel_deployment.test", "url"),
+					resource.TestCheckResourceAttrPair("data.vercel_deployment.by_id", "project_id", "vercel_project.test", "id"),
+					resource.TestCheckResourceAttr("data.vercel_deployment.by_id", "target", "production"),
+					resource.TestCheckResourceAttr("data.vercel_deployment.by_id", "ready_state", "READY"),
+					resource.TestCheckResourceAttrSet("data.vercel_deployment.by_id", "aliases.#"),
+					resource.TestCheckResourceAttrSet("data.vercel_deployment.by_id", "creator"),
+					resource.TestCheckResourceAttrSet("data.vercel_deployment.by_id", "created_at"),
+					resource.TestCheckNoResourceAttr("data.vercel_deployment.by_id", "ref"),
+					resource.TestCheckResourceAttrPair("data.vercel_deployment.by_url", "id", "vercel_deployment.test", "id"),
+					resource.TestCheckResourceAttrPair("data.vercel_deployment.by_url", "project_id", "vercel_project.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDeploymentDataSourceConfig(projectSuffix, teamID string) string {
+	return testAccDeploymentConfig(projectSuffix, teamID, "") + fmt.Sprintf(`
+data "vercel_deployment" "by_id" {
+  id = vercel_deployment.test.id
+  %[1]s
+}
+
+data "vercel_deployment" "by_url" {
+  url = "https://${vercel_deployment.test.url}"
+  %[1]s
+}
+`, teamID)
+}
@@ -0,0 +1,231 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &deploymentsDataSource{}
+)
+
+func newDeploymentsDataSource() datasource.DataSource {
+	return &deploymentsDataSource{}
+}
+
+type deploymentsDataSource struct {
+	client *client.Client
+}
+
+func (d *deploymentsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployments"
+}
+
+func (d *deploymentsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// defaultDeploymentsLimit is the maximum number of deployments returned if limit is not set.
+const defaultDeploymentsLimit = 100
+
+// Schema returns the schema information for a deployments data source
+func (d *deploymentsDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides information about the existing deployments within Vercel, newest first.
+
+The deployments can be filtered by project, target, state, git branch or commit, and when they were created.
+For example, this can be used to find the latest ready preview deployment of a branch.
+`,
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The team ID to list deployments for. Required when configuring a team resource if a default team has not been set in the provider.",
+			},
+			"project_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only include deployments of this project.",
+			},
+			"target": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only include deployments with this target. Must be either `production` or `preview`.",
+				Validators: []validator.String{
+					stringOneOf("production", "preview"),
+				},
+			},
+			"state": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only include deployments in this state. Must be one of `BUILDING`, `ERROR`, `INITIALIZING`, `QUEUED`, `READY` or `CANCELED`.",
+				Validators: []validator.String{
+					stringOneOf("BUILDING", "ERROR", "INITIALIZING", "QUEUED", "READY", "CANCELED"),
+				},
+			},
+			"branch": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only include deployments built from this git branch.",
+			},
+			"sha": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only include deployments built from this git commit SHA.",
+			},
+			"created_after": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only include deployments created after this time, in RFC 3339 format. For example: `2023-01-02T15:04:05Z`.",
+			},
+			"created_before": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only include deployments created before this time, in RFC 3339 format. For example: `2023-01-02T15:04:05Z`.",
+			},
+			"limit": schema.Int64Attribute{
+				Optional:    true,
+				Description: fmt.Sprintf("The maximum number of deployments to return. Defaults to %d.", defaultDeploymentsLimit),
+				Validators: []validator.Int64{
+					int64GreaterThan(0),
+				},
+			},
+			"deployments": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The deployments that match the filters, newest first.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the deployment.",
+						},
+						"url": schema.StringAttribute{
+							Computed:    true,
+							Description: "A unique URL that is automatically generated for the deployment.",
+						},
+						"ready_state": schema.StringAttribute{
+							Computed:    true,
+							Description: "The state of the deployment. One of `BUILDING`, `ERROR`, `INITIALIZING`, `QUEUED`, `READY` or `CANCELED`.",
+						},
+						"target": schema.StringAttribute{
+							Computed:    true,
+							Description: "The target of the deployment. Either `production` or `preview`.",
+						},
+						"ref": schema.StringAttribute{
+							Computed:    true,
+							Description: "The git branch the deployment was built from, if it was created from a git repository.",
+						},
+						"sha": schema.StringAttribute{
+							Computed:    true,
+							Description: "The git commit SHA the deployment was built from, if it was created from a git repository.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "When the deployment was created, in RFC 3339 format.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// parseOptionalTime parses an optional RFC 3339 time attribute, adding a diagnostic if it is invalid.
+func parseOptionalTime(value types.String, attribute string, diags *diag.Diagnostics) time.Time {
+	if value.IsNull() {
+		return time.Time{}
+	}
+	t, err := time.Parse(time.RFC3339, value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root(attribute),
+			"Invalid time",
+			fmt.Sprintf("%s must be in RFC 3339 format, such as 2023-01-02T15:04:05Z: %s", attribute, err),
+		)
+	}
+	return t
+}
+
+// Read will list the deployments that match the filters from the Vercel API, and will update terraform
+// with this information.
+// It is called by the provider whenever data source values should be read to update state.
+func (d *deploymentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config DeploymentsDataSource
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	since := parseOptionalTime(config.CreatedAfter, "created_after", &resp.Diagnostics)
+	until := parseOptionalTime(config.CreatedBefore, "created_before", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	limit := defaultDeploymentsLimit
+	if !config.Limit.IsNull() {
+		limit = int(config.Limit.ValueInt64())
+	}
+
+	out, err := d.client.ListDeployments(ctx, client.ListDeploymentsRequest{
+		ProjectID: config.ProjectID.ValueString(),
+		Target:    config.Target.ValueString(),
+		State:     config.State.ValueString(),
+		GitRef:    config.Branch.ValueString(),
+		GitSHA:    config.SHA.ValueString(),
+		Limit:     limit,
+		Filters: client.ListFilters{
+			Since: since,
+			Until: until,
+		},
+		TeamID: config.TeamID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading deployments",
+			fmt.Sprintf("Could not list deployments %s %s, unexpected error: %s",
+				config.TeamID.ValueString(),
+				config.ProjectID.ValueString(),
+				describeError(err),
+			),
+		)
+		return
+	}
+
+	result := config
+	result.TeamID = toTeamID(d.client.TeamID(config.TeamID.ValueString()))
+	result.Deployments = []DeploymentSummary{}
+	for _, deployment := range out {
+		result.Deployments = append(result.Deployments, convertResponseToDeploymentSummary(deployment))
+	}
+	tflog.Trace(ctx, "read deployments", map[string]interface{}{
+		"team_id":     result.TeamID.ValueString(),
+		"project_id":  result.ProjectID.ValueString(),
+		"deployments": len(result.Deployments),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,50 @@
+package vercel
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+// DeploymentsDataSource reflects the state terraform stores internally for a deployments data source.
+type DeploymentsDataSource struct {
+	TeamID        types.String        `tfsdk:"team_id"`
+	ProjectID     types.String        `tfsdk:"project_id"`
+	Target        types.String        `tfsdk:"target"`
+	State         types.String        `tfsdk:"state"`
+	Branch        types.String        `tfsdk:"branch"`
+	SHA           types.String        `tfsdk:"sha"`
+	CreatedAfter  types.String        `tfsdk:"created_after"`
+	CreatedBefore types.String        `tfsdk:"created_before"`
+	Limit         types.Int64         `tfsdk:"limit"`
+	Deployments   []DeploymentSummary `tfsdk:"deployments"`
+}
+
+// DeploymentSummary reflects the information terraform stores about each deployment within a
+// deployments data source.
+type DeploymentSummary struct {
+	ID         types.String `tfsdk:"id"`
+	URL        types.String `tfsdk:"url"`
+	ReadyState types.String `tfsdk:"ready_state"`
+	Target     types.String `tfsdk:"target"`
+	Ref        types.String `tfsdk:"ref"`
+	SHA        types.String `tfsdk:"sha"`
+	CreatedAt  types.String `tfsdk:"created_at"`
+}
+
+func convertResponseToDeploymentSummary(response client.DeploymentSummary) DeploymentSummary {
+	target := types.StringValue("preview")
+	if response.Target != nil && *response.Target != "" {
+		target = types.StringValue(*response.Target)
+	}
+	return DeploymentSummary{
+		ID:         types.StringValue(response.ID),
+		URL:        types.StringValue(response.URL),
+		ReadyState: types.StringValue(response.State),
+		Target:     target,
+		Ref:        fromOptionalString(response.GitRef()),
+		SHA:        fromOptionalString(response.GitSHA()),
+		CreatedAt:  types.StringValue(time.UnixMilli(response.Created).UTC().Format(time.RFC3339)),
+	}
+}
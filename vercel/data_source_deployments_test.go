@@ -0,0 +1,51 @@
+package vercel_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_DeploymentsDataSource(t *testing.T) {
+	projectSuffix := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		CheckDestroy:             noopDestroyCheck,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentsDataSourceConfig(projectSuffix, teamIDConfig()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vercel_deployments.production", "deployments.#", "1"),
+					resource.TestCheckResourceAttrPair("data.vercel_deployments.production", "deployments.0.id", "vercel_deployment.test", "id"),
+					resource.TestCheckResourceAttrPair("data.vercel_deployments.production", "deployments.0.url", "vercel_deployment.test", "url"),
+					resource.TestCheckResourceAttr("data.vercel_deployments.production", "deployments.0.ready_state", "READY"),
+					resource.TestCheckResourceAttr("data.vercel_deployments.production", "deployments.0.target", "production"),
+					resource.TestCheckResourceAttrSet("data.vercel_deployments.production", "deployments.0.created_at"),
+					resource.TestCheckResourceAttr("data.vercel_deployments.preview", "deployments.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDeploymentsDataSourceConfig(projectSuffix, teamID string) string {
+	return testAccDeploymentConfig(projectSuffix, teamID, "") + fmt.Sprintf(`
+data "vercel_deployments" "production" {
+  project_id = vercel_project.test.id
+  target     = "production"
+  state      = "READY"
+  %[1]s
+  depends_on = [vercel_deployment.test]
+}
+
+data "vercel_deployments" "preview" {
+  project_id = vercel_project.test.id
+  target     = "preview"
+  %[1]s
+  depends_on = [vercel_deployment.test]
+}
+`, teamID)
+}
@@ -0,0 +1,111 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &dnsZoneFileDataSource{}
+)
+
+func newDNSZoneFileDataSource() datasource.DataSource {
+	return &dnsZoneFileDataSource{}
+}
+
+type dnsZoneFileDataSource struct {
+	client *client.Client
+}
+
+func (d *dnsZoneFileDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zone_file"
+}
+
+func (d *dnsZoneFileDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Schema returns the schema information for a DNS zone file data source
+func (d *dnsZoneFileDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides the DNS records of a domain within Vercel, rendered as a BIND zone file.
+
+This can be used to export the records of a domain, for example to back them up or to migrate them to another DNS provider.
+`,
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The team ID that the domain belongs to. Required when configuring a team resource if a default team has not been set in the provider.",
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"domain": schema.StringAttribute{
+				Required:    true,
+				Description: "The domain name, or zone, to export the DNS records of.",
+			},
+			"content": schema.StringAttribute{
+				Computed:    true,
+				Description: "The DNS records of the domain, in BIND zone file format.",
+			},
+		},
+	}
+}
+
+// Read will read the DNS records of a domain by requesting them from the Vercel API, and will update terraform
+// with this information.
+// It is called by the provider whenever data source values should be read to update state.
+func (d *dnsZoneFileDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config DNSZoneFile
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := d.client.ListDNSRecords(ctx, config.Domain.ValueString(), config.TeamID.ValueString(), client.ListFilters{})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading DNS zone file",
+			fmt.Sprintf("Could not list DNS Records for domain %s, unexpected error: %s",
+				config.Domain.ValueString(),
+				describeError(err),
+			),
+		)
+		return
+	}
+
+	result := convertResponseToDNSZoneFile(out, config.Domain.ValueString(), d.client.TeamID(config.TeamID.ValueString()))
+	tflog.Trace(ctx, "read DNS zone file", map[string]interface{}{
+		"team_id": result.TeamID.ValueString(),
+		"domain":  result.Domain.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
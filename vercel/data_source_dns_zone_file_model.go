@@ -0,0 +1,23 @@
+package vercel
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+// DNSZoneFile reflects the state terraform stores internally for a DNS zone file data source.
+type DNSZoneFile struct {
+	TeamID  types.String `tfsdk:"team_id"`
+	ID      types.String `tfsdk:"id"`
+	Domain  types.String `tfsdk:"domain"`
+	Content types.String `tfsdk:"content"`
+}
+
+func convertResponseToDNSZoneFile(records []client.DNSRecord, domain, teamID string) DNSZoneFile {
+	return DNSZoneFile{
+		TeamID:  toTeamID(teamID),
+		ID:      types.StringValue(domain),
+		Domain:  types.StringValue(domain),
+		Content: types.StringValue(client.FormatZoneFile(domain, records)),
+	}
+}
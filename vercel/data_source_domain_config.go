@@ -0,0 +1,130 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &domainConfigDataSource{}
+)
+
+func newDomainConfigDataSource() datasource.DataSource {
+	return &domainConfigDataSource{}
+}
+
+type domainConfigDataSource struct {
+	client *client.Client
+}
+
+func (d *domainConfigDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_config"
+}
+
+func (d *domainConfigDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Schema returns the schema information for a domain config data source
+func (d *domainConfigDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides information about how the DNS of a domain is configured to point at Vercel.
+
+This is useful when the DNS for a domain is managed outside of Vercel, as the recommended records can be passed to the resources of another DNS provider.
+`,
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The team ID to check the domain configuration for. Required when configuring a team resource if a default team has not been set in the provider.",
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"domain": schema.StringAttribute{
+				Required:    true,
+				Description: "The domain name to check the configuration of, such as `www.example.com`.",
+			},
+			"misconfigured": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the DNS of the domain is not configured to point at Vercel.",
+			},
+			"configured_by": schema.StringAttribute{
+				Computed:    true,
+				Description: "How the domain is configured to point at Vercel, such as `A`, `CNAME`, `http` or `dns-01`. This is null if the domain is misconfigured.",
+			},
+			"accepted_challenges": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The challenges that can be used to issue a certificate for the domain, such as `dns-01` or `http-01`.",
+			},
+			"recommended_ipv4": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The IPv4 addresses that Vercel recommends `A` records are created for.",
+			},
+			"recommended_cname": schema.StringAttribute{
+				Computed:    true,
+				Description: "The value Vercel recommends a `CNAME` record points to.",
+			},
+		},
+	}
+}
+
+// Read will read the domain configuration by requesting it from the Vercel API, and will update terraform
+// with this information.
+// It is called by the provider whenever data source values should be read to update state.
+func (d *domainConfigDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config DomainConfig
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := d.client.GetDomainConfig(ctx, config.Domain.ValueString(), config.TeamID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading domain config",
+			fmt.Sprintf("Could not read configuration for domain %s, unexpected error: %s",
+				config.Domain.ValueString(),
+				describeError(err),
+			),
+		)
+		return
+	}
+
+	result := convertResponseToDomainConfig(out, config.Domain.ValueString())
+	tflog.Trace(ctx, "read domain config", map[string]interface{}{
+		"team_id": result.TeamID.ValueString(),
+		"domain":  result.Domain.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
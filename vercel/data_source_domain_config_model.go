@@ -0,0 +1,39 @@
+package vercel
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+// DomainConfig reflects the state terraform stores internally for a domain config data source.
+type DomainConfig struct {
+	TeamID             types.String   `tfsdk:"team_id"`
+	ID                 types.String   `tfsdk:"id"`
+	Domain             types.String   `tfsdk:"domain"`
+	Misconfigured      types.Bool     `tfsdk:"misconfigured"`
+	ConfiguredBy       types.String   `tfsdk:"configured_by"`
+	AcceptedChallenges []types.String `tfsdk:"accepted_challenges"`
+	RecommendedIPv4    []types.String `tfsdk:"recommended_ipv4"`
+	RecommendedCNAME   types.String   `tfsdk:"recommended_cname"`
+}
+
+func convertResponseToDomainConfig(response client.DomainConfigResponse, domain string) DomainConfig {
+	challenges := []types.String{}
+	for _, c := range response.AcceptedChallenges {
+		challenges = append(challenges, types.StringValue(c))
+	}
+	ipv4 := []types.String{}
+	for _, ip := range response.PreferredIPv4() {
+		ipv4 = append(ipv4, types.StringValue(ip))
+	}
+	return DomainConfig{
+		TeamID:             toTeamID(response.TeamID),
+		ID:                 types.StringValue(domain),
+		Domain:             types.StringValue(domain),
+		Misconfigured:      types.BoolValue(response.Misconfigured),
+		ConfiguredBy:       fromStringPointer(response.ConfiguredBy),
+		AcceptedChallenges: challenges,
+		RecommendedIPv4:    ipv4,
+		RecommendedCNAME:   fromOptionalString(response.PreferredCNAME()),
+	}
+}
@@ -0,0 +1,40 @@
+package vercel_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_DomainConfigDataSource(t *testing.T) {
+	domain := acctest.RandString(30) + ".example.com"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		CheckDestroy:             noopDestroyCheck,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainConfigDataSourceConfig(domain, teamIDConfig()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vercel_domain_config.test", "domain", domain),
+					resource.TestCheckResourceAttr("data.vercel_domain_config.test", "misconfigured", "true"),
+					resource.TestCheckNoResourceAttr("data.vercel_domain_config.test", "configured_by"),
+					resource.TestCheckResourceAttrSet("data.vercel_domain_config.test", "accepted_challenges.#"),
+					resource.TestCheckResourceAttrSet("data.vercel_domain_config.test", "recommended_ipv4.0"),
+					resource.TestCheckResourceAttrSet("data.vercel_domain_config.test", "recommended_cname"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDomainConfigDataSourceConfig(domain, teamID string) string {
+	return fmt.Sprintf(`
+data "vercel_domain_config" "test" {
+  domain = "%s"
+  %s
+}
+`, domain, teamID)
+}
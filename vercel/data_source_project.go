@@ -101,6 +101,11 @@ For more detailed information, please see the [Vercel documentation](https://ver
 							ElementType: types.StringType,
 							Computed:    true,
 						},
+						"custom_environment_ids": schema.SetAttribute{
+							Description: "The IDs of the custom environments that the environment variable should be present on.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
 						"key": schema.StringAttribute{
 							Description: "The name of the environment variable.",
 							Computed:    true,
@@ -117,6 +122,14 @@ For more detailed information, please see the [Vercel documentation](https://ver
 							Description: "The git branch of the environment variable.",
 							Computed:    true,
 						},
+						"type": schema.StringAttribute{
+							Description: "The type of the environment variable. One of `plain`, `encrypted` or `sensitive`. The value of a `sensitive` environment variable cannot be read.",
+							Computed:    true,
+						},
+						"comment": schema.StringAttribute{
+							Description: "A comment explaining what the environment variable is for.",
+							Computed:    true,
+						},
 					},
 				},
 			},
@@ -206,13 +219,13 @@ func (d *projectDataSource) Read(ctx context.Context, req datasource.ReadRequest
 			fmt.Sprintf("Could not read project %s %s, unexpected error: %s",
 				config.TeamID.ValueString(),
 				config.Name.ValueString(),
-				err,
+				describeError(err),
 			),
 		)
 		return
 	}
 
-	result := convertResponseToProjectDataSource(out, nullProject)
+	result := convertResponseToProjectDataSource(ctx, out, nullProject)
 	tflog.Trace(ctx, "read project", map[string]interface{}{
 		"team_id":    result.TeamID.ValueString(),
 		"project_id": result.ID.ValueString(),
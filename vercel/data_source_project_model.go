@@ -1,6 +1,8 @@
 package vercel
 
 import (
+	"context"
+
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/vercel/terraform-provider-vercel/client"
 )
@@ -29,8 +31,8 @@ type PasswordProtectionDataSource struct {
 	ProtectProduction types.Bool `tfsdk:"protect_production"`
 }
 
-func convertResponseToProjectDataSource(response client.ProjectResponse, plan Project) ProjectDataSource {
-	project := convertResponseToProject(response, plan)
+func convertResponseToProjectDataSource(ctx context.Context, response client.ProjectResponse, plan Project) ProjectDataSource {
+	project := convertResponseToProject(ctx, response, plan)
 
 	var pp *PasswordProtectionDataSource
 	if project.PasswordProtection != nil {
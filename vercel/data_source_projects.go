@@ -0,0 +1,203 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &projectsDataSource{}
+)
+
+func newProjectsDataSource() datasource.DataSource {
+	return &projectsDataSource{}
+}
+
+type projectsDataSource struct {
+	client *client.Client
+}
+
+func (d *projectsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_projects"
+}
+
+func (d *projectsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// projectAttributes returns the attributes of each project within the data source. These are the
+// same as those of the vercel_project data source, except that every attribute is computed.
+func projectAttributes(ctx context.Context) map[string]schema.Attribute {
+	var resp datasource.SchemaResponse
+	(&projectDataSource{}).Schema(ctx, datasource.SchemaRequest{}, &resp)
+
+	attributes := map[string]schema.Attribute{}
+	for name, attribute := range resp.Schema.Attributes {
+		attributes[name] = attribute
+	}
+	attributes["team_id"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "The team ID the project exists beneath.",
+	}
+	attributes["name"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "The name of the project.",
+	}
+	passwordProtection := resp.Schema.Attributes["password_protection"].(schema.SingleNestedAttribute)
+	passwordProtection.Optional = false
+	passwordProtection.Computed = true
+	attributes["password_protection"] = passwordProtection
+	return attributes
+}
+
+// Schema returns the schema information for a projects data source
+func (d *projectsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides information about all of the existing projects within a Vercel team, or personal account.
+
+The projects can be filtered by name, framework and git repository. This is useful for configuring
+something for every project, such as a shared environment variable.
+
+For more detailed information, please see the [Vercel documentation](https://vercel.com/docs/concepts/projects/overview).
+        `,
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The team ID to list projects for. Required when configuring a team resource if a default team has not been set in the provider.",
+			},
+			"name_prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only include projects whose name starts with this prefix.",
+			},
+			"name_regex": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only include projects whose name matches this regular expression.",
+				Validators: []validator.String{
+					stringValidRegex(),
+				},
+			},
+			"framework": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only include projects using this framework. For example: `nextjs`.",
+			},
+			"repository": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only include projects connected to this git repository. For example: `vercel/next.js`.",
+			},
+			"git_provider": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only include projects connected to a git repository with this provider. Must be either `github`, `gitlab`, or `bitbucket`.",
+				Validators: []validator.String{
+					stringOneOf("github", "gitlab", "bitbucket"),
+				},
+			},
+			"projects": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The projects that match the filters, sorted by name. Each project has the same attributes as the `vercel_project` data source.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: projectAttributes(ctx),
+				},
+			},
+		},
+	}
+}
+
+// Read will list the projects from the Vercel API, and update terraform with the details of those that
+// match the configured filters.
+// It is called by the provider whenever data source values should be read to update state.
+func (d *projectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ProjectsDataSource
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !config.NameRegex.IsNull() {
+		nameRegex = regexp.MustCompile(config.NameRegex.ValueString())
+	}
+
+	projects, err := d.client.ListProjects(ctx, config.TeamID.ValueString(), client.ListFilters{
+		Search: config.NamePrefix.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading projects",
+			fmt.Sprintf("Could not list projects %s, unexpected error: %s",
+				config.TeamID.ValueString(),
+				describeError(err),
+			),
+		)
+		return
+	}
+	sort.Slice(projects, func(i, j int) bool {
+		return projects[i].Name < projects[j].Name
+	})
+
+	result := ProjectsDataSource{
+		TeamID:      toTeamID(d.client.TeamID(config.TeamID.ValueString())),
+		NamePrefix:  config.NamePrefix,
+		NameRegex:   config.NameRegex,
+		Framework:   config.Framework,
+		Repository:  config.Repository,
+		GitProvider: config.GitProvider,
+		Projects:    []ProjectDataSource{},
+	}
+	for _, p := range projects {
+		if !config.matches(p, nameRegex) {
+			continue
+		}
+		// Listing projects does not include decrypted environment variables, so the
+		// project needs to be read again to include them.
+		out, err := d.client.GetProject(ctx, p.ID, config.TeamID.ValueString(), true)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading projects",
+				fmt.Sprintf("Could not read project %s %s, unexpected error: %s",
+					config.TeamID.ValueString(),
+					p.Name,
+					describeError(err),
+				),
+			)
+			return
+		}
+		result.Projects = append(result.Projects, convertResponseToProjectDataSource(ctx, out, nullProject))
+	}
+
+	tflog.Trace(ctx, "read projects", map[string]interface{}{
+		"team_id":  result.TeamID.ValueString(),
+		"projects": len(result.Projects),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
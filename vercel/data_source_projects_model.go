@@ -0,0 +1,41 @@
+package vercel
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+// ProjectsDataSource reflects the state terraform stores internally for a projects data source.
+type ProjectsDataSource struct {
+	TeamID      types.String        `tfsdk:"team_id"`
+	NamePrefix  types.String        `tfsdk:"name_prefix"`
+	NameRegex   types.String        `tfsdk:"name_regex"`
+	Framework   types.String        `tfsdk:"framework"`
+	Repository  types.String        `tfsdk:"repository"`
+	GitProvider types.String        `tfsdk:"git_provider"`
+	Projects    []ProjectDataSource `tfsdk:"projects"`
+}
+
+// matches determines whether a project satisfies all of the filters that have been configured.
+func (p ProjectsDataSource) matches(project client.ProjectResponse, nameRegex *regexp.Regexp) bool {
+	if !strings.HasPrefix(project.Name, p.NamePrefix.ValueString()) {
+		return false
+	}
+	if nameRegex != nil && !nameRegex.MatchString(project.Name) {
+		return false
+	}
+	if !p.Framework.IsNull() && (project.Framework == nil || *project.Framework != p.Framework.ValueString()) {
+		return false
+	}
+	repo := project.Repository()
+	if !p.Repository.IsNull() && (repo == nil || !strings.EqualFold(repo.Repo, p.Repository.ValueString())) {
+		return false
+	}
+	if !p.GitProvider.IsNull() && (repo == nil || repo.Type != p.GitProvider.ValueString()) {
+		return false
+	}
+	return true
+}
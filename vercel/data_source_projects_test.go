@@ -0,0 +1,78 @@
+package vercel_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_ProjectsDataSource(t *testing.T) {
+	name := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectsDataSourceConfig(name, teamIDConfig()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vercel_projects.all", "projects.#", "2"),
+					resource.TestCheckResourceAttr("data.vercel_projects.all", "projects.0.name", "test-acc-"+name+"-a"),
+					resource.TestCheckResourceAttr("data.vercel_projects.all", "projects.1.name", "test-acc-"+name+"-b"),
+					resource.TestCheckResourceAttr("data.vercel_projects.nextjs", "projects.#", "1"),
+					resource.TestCheckResourceAttr("data.vercel_projects.nextjs", "projects.0.name", "test-acc-"+name+"-a"),
+					resource.TestCheckResourceAttr("data.vercel_projects.nextjs", "projects.0.build_command", "npm run build"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.vercel_projects.nextjs", "projects.0.environment.*", map[string]string{
+						"key":   "foo",
+						"value": "bar",
+					}),
+					resource.TestCheckResourceAttr("data.vercel_projects.regex", "projects.#", "1"),
+					resource.TestCheckResourceAttr("data.vercel_projects.regex", "projects.0.name", "test-acc-"+name+"-b"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProjectsDataSourceConfig(name, teamID string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "a" {
+  name = "test-acc-%[1]s-a"
+  build_command = "npm run build"
+  framework = "nextjs"
+  %[2]s
+  environment = [
+    {
+      key    = "foo"
+      value  = "bar"
+      target = ["production"]
+    }
+  ]
+}
+
+resource "vercel_project" "b" {
+  name = "test-acc-%[1]s-b"
+  %[2]s
+}
+
+data "vercel_projects" "all" {
+  name_prefix = "test-acc-%[1]s-"
+  %[2]s
+  depends_on = [vercel_project.a, vercel_project.b]
+}
+
+data "vercel_projects" "nextjs" {
+  name_prefix = "test-acc-%[1]s-"
+  framework = "nextjs"
+  %[2]s
+  depends_on = [vercel_project.a, vercel_project.b]
+}
+
+data "vercel_projects" "regex" {
+  name_regex = "^test-acc-%[1]s-[b-z]$"
+  %[2]s
+  depends_on = [vercel_project.a, vercel_project.b]
+}
+`, name, teamID)
+}
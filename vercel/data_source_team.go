@@ -0,0 +1,125 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &teamDataSource{}
+)
+
+func newTeamDataSource() datasource.DataSource {
+	return &teamDataSource{}
+}
+
+type teamDataSource struct {
+	client *client.Client
+}
+
+func (d *teamDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team"
+}
+
+func (d *teamDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Schema returns the schema information for a team data source
+func (d *teamDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides information about an existing Team within Vercel.
+
+This can be used to look up the ID of a team from its slug, so that it can be used as the ` + "`team_id`" + ` of other resources.
+`,
+		Attributes: map[string]schema.Attribute{
+			"slug": schema.StringAttribute{
+				Required:    true,
+				Description: "The slug of the team.",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the team.",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the team.",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "A description of the team.",
+			},
+			"avatar_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the avatar of the team, if one has been set.",
+			},
+			"default_vercel_authentication": schema.SingleNestedAttribute{
+				Description: "The Vercel Authentication (SSO protection) applied by default to new projects within the team.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"protect_production": schema.BoolAttribute{
+						Description: "If true, production deployments will also be protected",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read will read the team information by requesting it from the Vercel API, and will update terraform
+// with this information.
+// It is called by the provider whenever data source values should be read to update state.
+func (d *teamDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config TeamDataSource
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := d.client.GetTeam(ctx, config.Slug.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading team",
+			fmt.Sprintf("Could not read team %s, unexpected error: %s",
+				config.Slug.ValueString(),
+				describeError(err),
+			),
+		)
+		return
+	}
+
+	result := convertResponseToTeamDataSource(out, config.Slug)
+	tflog.Trace(ctx, "read team", map[string]interface{}{
+		"team_id": result.ID.ValueString(),
+		"slug":    result.Slug.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
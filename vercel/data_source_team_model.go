@@ -0,0 +1,28 @@
+package vercel
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+// TeamDataSource reflects the state terraform stores internally for a team data source.
+type TeamDataSource struct {
+	Slug                        types.String          `tfsdk:"slug"`
+	ID                          types.String          `tfsdk:"id"`
+	Name                        types.String          `tfsdk:"name"`
+	Description                 types.String          `tfsdk:"description"`
+	AvatarID                    types.String          `tfsdk:"avatar_id"`
+	DefaultVercelAuthentication *VercelAuthentication `tfsdk:"default_vercel_authentication"`
+}
+
+func convertResponseToTeamDataSource(response client.TeamResponse, slug types.String) TeamDataSource {
+	team := convertResponseToTeam(response, types.StringNull())
+	return TeamDataSource{
+		Slug:                        slug,
+		ID:                          team.ID,
+		Name:                        team.Name,
+		Description:                 team.Description,
+		AvatarID:                    team.AvatarID,
+		DefaultVercelAuthentication: team.DefaultVercelAuthentication,
+	}
+}
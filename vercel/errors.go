@@ -0,0 +1,35 @@
+package vercel
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+// describeError formats an error returned by the Vercel API for use in the detail of a diagnostic.
+// Errors that can be resolved by the user, such as an expired API token, are followed by advice on
+// how to do so.
+func describeError(err error) string {
+	var rateLimitErr client.RateLimitError
+	var validationErr client.ValidationError
+	switch {
+	case errors.Is(err, client.ErrUnauthorized):
+		return err.Error() + "\n\nThe API token is invalid or has expired. Please check the `api_token` field of the provider, or the VERCEL_API_TOKEN environment variable."
+	case errors.Is(err, client.ErrForbidden):
+		return err.Error() + "\n\nThe API token does not have permission to perform this action. Please check the `team_id` is correct (or the `team` field of the provider, if it is not set), and that the API token has access to that team."
+	case errors.Is(err, client.ErrPaymentRequired):
+		return err.Error() + "\n\nThis exceeds the limits of the Vercel plan, or requires a feature the plan does not include. Please check the plan's usage and limits in the Vercel dashboard."
+	case errors.Is(err, client.ErrConflict):
+		return err.Error() + "\n\nThis conflicts with something that already exists in Vercel. If it should be managed by Terraform, it can be imported with `terraform import` instead."
+	case errors.As(err, &rateLimitErr):
+		advice := "\n\nToo many requests have been made to the Vercel API, and the provider stopped retrying. Reducing the number of concurrent operations, e.g. with `terraform apply -parallelism=2`, may help."
+		if rateLimitErr.RetryAfter > 0 {
+			advice += fmt.Sprintf(" Vercel asked for requests to be retried after %s.", rateLimitErr.RetryAfter)
+		}
+		return err.Error() + advice
+	case errors.As(err, &validationErr) && validationErr.Field != "":
+		return err.Error() + fmt.Sprintf("\n\nVercel rejected the `%s` field of the request as invalid. Please check the corresponding value in the configuration.", validationErr.Field)
+	}
+	return err.Error()
+}
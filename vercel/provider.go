@@ -3,26 +3,40 @@ package vercel
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/url"
 	"os"
 	"regexp"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/vercel/terraform-provider-vercel/client"
 )
 
-type vercelProvider struct{}
+type vercelProvider struct {
+	version string
+}
 
-// New instantiates a new instance of a vercel terraform provider.
-func New() provider.Provider {
-	return &vercelProvider{}
+// New returns a function that instantiates a new instance of a vercel terraform provider.
+// The version is reported to Vercel as part of the User-Agent of any API requests.
+func New(version string) func() provider.Provider {
+	return func() provider.Provider {
+		return &vercelProvider{
+			version: version,
+		}
+	}
 }
 
 func (p *vercelProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = "vercel"
+	resp.Version = p.version
 }
 
 // Schema returns the schema information for the provider configuration itself.
@@ -44,6 +58,42 @@ Use the navigation to the left to read about the available resources.
 				Optional:    true,
 				Description: "The default Vercel Team to use when creating resources. This can be provided as either a team slug, or team ID. The slug and ID are both available from the Team Settings page in the Vercel dashboard.",
 			},
+			"api_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "The base URL of the Vercel API. This can also be specified with the `VERCEL_API_URL` shell environment variable. Defaults to `https://api.vercel.com`. This is typically only useful for testing against a mock of the API.",
+				Validators: []validator.String{
+					stringRegex(
+						regexp.MustCompile(`^https?://`),
+						"The api_url must be a http or https URL",
+					),
+				},
+			},
+			"proxy_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "The URL of a HTTP proxy to send all requests to the Vercel API through. If not specified, the standard `HTTPS_PROXY` and `NO_PROXY` shell environment variables are respected.",
+				Validators: []validator.String{
+					stringRegex(
+						regexp.MustCompile(`^(https?|socks5)://`),
+						"The proxy_url must be a http, https or socks5 URL",
+					),
+				},
+			},
+			"request_timeout": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The maximum number of seconds a single request to the Vercel API may take, including uploading any files. Defaults to 300 seconds.",
+				Validators: []validator.Int64{
+					int64GreaterThan(0),
+				},
+			},
+			"user_agent_suffix": schema.StringAttribute{
+				Optional:    true,
+				Description: "A value to append to the User-Agent header of requests made to the Vercel API. The User-Agent always identifies the provider and its version.",
+			},
+			"headers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Additional HTTP headers to send with every request to the Vercel API.",
+			},
 		},
 	}
 }
@@ -51,28 +101,44 @@ Use the navigation to the left to read about the available resources.
 func (p *vercelProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		newAliasResource,
+		newCustomEnvironmentResource,
 		newDeploymentResource,
 		newDNSRecordResource,
+		newDNSZoneResource,
+		newDomainResource,
 		newProjectResource,
 		newProjectDomainResource,
 		newProjectEnvironmentVariableResource,
 		newSharedEnvironmentVariableResource,
+		newTeamMemberResource,
+		newTeamResource,
 	}
 }
 
 func (p *vercelProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		newAliasDataSource,
+		newDeploymentDataSource,
+		newDeploymentsDataSource,
+		newDNSZoneFileDataSource,
+		newDomainConfigDataSource,
 		newFileDataSource,
 		newPrebuiltProjectDataSource,
 		newProjectDataSource,
 		newProjectDirectoryDataSource,
+		newProjectsDataSource,
+		newTeamDataSource,
 	}
 }
 
 type providerData struct {
-	APIToken types.String `tfsdk:"api_token"`
-	Team     types.String `tfsdk:"team"`
+	APIToken        types.String `tfsdk:"api_token"`
+	Team            types.String `tfsdk:"team"`
+	APIURL          types.String `tfsdk:"api_url"`
+	ProxyURL        types.String `tfsdk:"proxy_url"`
+	RequestTimeout  types.Int64  `tfsdk:"request_timeout"`
+	UserAgentSuffix types.String `tfsdk:"user_agent_suffix"`
+	Headers         types.Map    `tfsdk:"headers"`
 }
 
 // apiTokenRe is a regex for an API access token. We use this to validate that the
@@ -121,7 +187,15 @@ func (p *vercelProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
-	vercelClient := client.New(apiToken)
+	ctx = maskSensitiveLogFields(ctx, apiToken)
+
+	opts, diags := p.clientOptions(ctx, req.TerraformVersion, config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vercelClient := client.New(apiToken, opts...)
 	if config.Team.ValueString() != "" {
 		res, err := vercelClient.GetTeam(ctx, config.Team.ValueString())
 		if client.NotFound(err) {
@@ -134,7 +208,7 @@ func (p *vercelProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unexpected error reading Vercel Team",
-				fmt.Sprintf("Could not read Vercel Team %s, unexpected error: %s", config.Team.ValueString(), err),
+				fmt.Sprintf("Could not read Vercel Team %s, unexpected error: %s", config.Team.ValueString(), describeError(err)),
 			)
 			return
 		}
@@ -144,3 +218,60 @@ func (p *vercelProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	resp.DataSourceData = vercelClient
 	resp.ResourceData = vercelClient
 }
+
+// maskSensitiveLogFields registers the provider's secrets with tflog, so that they are masked in logs
+// written while the provider is configured. This covers the API token itself, as well as any log field
+// that the client considers sensitive. Terraform creates a new context for every resource and data
+// source operation, so these masks do not carry over to them; the client masks its own logs instead.
+func maskSensitiveLogFields(ctx context.Context, apiToken string) context.Context {
+	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, append([]string{"api_token"}, client.SensitiveLogFields...)...)
+	ctx = tflog.MaskAllFieldValuesStrings(ctx, apiToken)
+	return tflog.MaskMessageStrings(ctx, apiToken)
+}
+
+// clientOptions converts the optional provider configuration into options for the
+// Vercel API client.
+func (p *vercelProvider) clientOptions(ctx context.Context, terraformVersion string, config providerData) (opts []client.Option, diags diag.Diagnostics) {
+	userAgent := fmt.Sprintf("terraform-provider-vercel/%s terraform/%s", p.version, terraformVersion)
+	if config.UserAgentSuffix.ValueString() != "" {
+		userAgent = fmt.Sprintf("%s %s", userAgent, config.UserAgentSuffix.ValueString())
+	}
+	opts = append(opts, client.WithUserAgent(userAgent))
+
+	apiURL := config.APIURL.ValueString()
+	if config.APIURL.IsNull() {
+		apiURL = os.Getenv("VERCEL_API_URL")
+	}
+	if apiURL != "" {
+		opts = append(opts, client.WithBaseURL(apiURL))
+	}
+
+	if config.ProxyURL.ValueString() != "" {
+		proxyURL, err := url.Parse(config.ProxyURL.ValueString())
+		if err != nil {
+			diags.AddError(
+				"Invalid proxy_url",
+				fmt.Sprintf("Could not parse proxy_url %s: %s", config.ProxyURL.ValueString(), err),
+			)
+			return nil, diags
+		}
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.Proxy = http.ProxyURL(proxyURL)
+		opts = append(opts, client.WithTransport(transport))
+	}
+
+	if !config.RequestTimeout.IsNull() && !config.RequestTimeout.IsUnknown() {
+		opts = append(opts, client.WithTimeout(time.Duration(config.RequestTimeout.ValueInt64())*time.Second))
+	}
+
+	if !config.Headers.IsNull() && !config.Headers.IsUnknown() {
+		headers := map[string]string{}
+		diags.Append(config.Headers.ElementsAs(ctx, &headers, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		opts = append(opts, client.WithHeaders(headers))
+	}
+
+	return opts, diags
+}
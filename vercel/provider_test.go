@@ -8,11 +8,37 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/vercel/terraform-provider-vercel/client"
+	"github.com/vercel/terraform-provider-vercel/client/clienttest"
 	"github.com/vercel/terraform-provider-vercel/vercel"
 )
 
 var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
-	"vercel": providerserver.NewProtocol6WithError(vercel.New()),
+	"vercel": providerserver.NewProtocol6WithError(vercel.New("test")()),
+}
+
+// TestMain allows the acceptance tests to be run against an in-memory fake of the
+// Vercel API, rather than the real thing, by setting VERCEL_TERRAFORM_TESTING_FAKE_API.
+func TestMain(m *testing.M) {
+	if os.Getenv("VERCEL_TERRAFORM_TESTING_FAKE_API") == "" {
+		os.Exit(m.Run())
+	}
+
+	server := clienttest.NewServer()
+	fakeEnv := map[string]string{
+		"VERCEL_API_TOKEN":                        server.Token,
+		"VERCEL_API_URL":                          server.URL,
+		"VERCEL_TERRAFORM_TESTING_GITHUB_REPO":    "vercel/terraform-provider-vercel-test",
+		"VERCEL_TERRAFORM_TESTING_GITLAB_REPO":    "vercel/terraform-provider-vercel-test",
+		"VERCEL_TERRAFORM_TESTING_BITBUCKET_REPO": "vercel/terraform-provider-vercel-test",
+		"VERCEL_TERRAFORM_TESTING_DOMAIN":         "terraform-provider-vercel.test",
+		"VERCEL_TERRAFORM_TESTING_TEAM":           server.AddTeam("terraform-testing", "Terraform Testing"),
+	}
+	for k, v := range fakeEnv {
+		os.Setenv(k, v)
+	}
+	code := m.Run()
+	server.Close()
+	os.Exit(code)
 }
 
 func mustHaveEnv(t *testing.T, name string) {
@@ -33,7 +59,11 @@ var tc *client.Client
 
 func testClient() *client.Client {
 	if tc == nil {
-		tc = client.New(apiToken())
+		var opts []client.Option
+		if apiURL := os.Getenv("VERCEL_API_URL"); apiURL != "" {
+			opts = append(opts, client.WithBaseURL(apiURL))
+		}
+		tc = client.New(apiToken(), opts...)
 	}
 
 	return tc
@@ -95,7 +95,7 @@ func (r *aliasResource) Create(ctx context.Context, req resource.CreateRequest,
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating alias",
-			"Could not create alias, unexpected error: "+err.Error(),
+			"Could not create alias, unexpected error: "+describeError(err),
 		)
 		return
 	}
@@ -135,7 +135,7 @@ func (r *aliasResource) Read(ctx context.Context, req resource.ReadRequest, resp
 			fmt.Sprintf("Could not get alias %s %s, unexpected error: %s",
 				state.TeamID.ValueString(),
 				state.ID.ValueString(),
-				err,
+				describeError(err),
 			),
 		)
 		return
@@ -195,7 +195,7 @@ func (r *aliasResource) Delete(ctx context.Context, req resource.DeleteRequest,
 			fmt.Sprintf(
 				"Could not delete alias %s, unexpected error: %s",
 				state.Alias.ValueString(),
-				err,
+				describeError(err),
 			),
 		)
 		return
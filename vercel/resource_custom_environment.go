@@ -0,0 +1,326 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+var (
+	_ resource.Resource                = &customEnvironmentResource{}
+	_ resource.ResourceWithConfigure   = &customEnvironmentResource{}
+	_ resource.ResourceWithImportState = &customEnvironmentResource{}
+)
+
+func newCustomEnvironmentResource() resource.Resource {
+	return &customEnvironmentResource{}
+}
+
+type customEnvironmentResource struct {
+	client *client.Client
+}
+
+func (r *customEnvironmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_environment"
+}
+
+func (r *customEnvironmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema returns the schema information for a custom environment resource.
+func (r *customEnvironmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides a Custom Environment resource.
+
+Custom Environments are used to deploy a ` + "`vercel_project`" + ` to environments other than ` + "`production`, `preview` and `development`" + `, such as a staging environment.
+
+Deployments can be made to a Custom Environment by setting ` + "`custom_environment`" + ` on a ` + "`vercel_deployment`" + `, or automatically for git branches matching ` + "`branch_tracking`" + `. Environment Variables and Project Domains can be assigned to a Custom Environment through their ` + "`custom_environment_ids`" + ` and ` + "`custom_environment_id`" + ` attributes.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:   "The ID of the Custom Environment.",
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"team_id": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "The ID of the Vercel team. Required when configuring a team resource if a default team has not been set in the provider.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace(), stringplanmodifier.UseStateForUnknown()},
+			},
+			"project_id": schema.StringAttribute{
+				Description:   "The ID of the Vercel project the Custom Environment belongs to.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the Custom Environment. This cannot be `production`, `preview` or `development`.",
+				Required:    true,
+				Validators: []validator.String{
+					stringLengthBetween(1, 32),
+					stringNotOneOf("production", "preview", "development"),
+				},
+			},
+			"slug": schema.StringAttribute{
+				Description: "The slug of the Custom Environment, derived from its name. This can be used in place of the ID in the `custom_environment` of a `vercel_deployment`.",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "A description of the Custom Environment.",
+				Optional:    true,
+			},
+			"branch_tracking": schema.SingleNestedAttribute{
+				Description: "Git branches matching this rule are automatically deployed to the Custom Environment.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"pattern": schema.StringAttribute{
+						Description: "The value to match git branch names against.",
+						Required:    true,
+						Validators: []validator.String{
+							stringLengthBetween(1, 100),
+						},
+					},
+					"type": schema.StringAttribute{
+						Description: "How git branch names are matched against the pattern. Must be one of `equals`, `startsWith` or `endsWith`. Defaults to `startsWith`.",
+						Optional:    true,
+						Computed:    true,
+						Default:     stringdefault.StaticString("startsWith"),
+						Validators: []validator.String{
+							stringOneOf("equals", "startsWith", "endsWith"),
+						},
+					},
+				},
+			},
+			"domains": schema.SetAttribute{
+				Description:   "The domains assigned to the Custom Environment. Domains are assigned with the `custom_environment_id` attribute of a `vercel_project_domain`.",
+				Computed:      true,
+				ElementType:   types.StringType,
+				PlanModifiers: []planmodifier.Set{setplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+// Create will create a new custom environment for a Vercel project.
+// This is called automatically by the provider when a new resource should be created.
+func (r *customEnvironmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan CustomEnvironment
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := r.client.CreateCustomEnvironment(ctx, plan.toCreateCustomEnvironmentRequest())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating custom environment",
+			fmt.Sprintf(
+				"Could not create custom environment %s for project %s, unexpected error: %s",
+				plan.Name.ValueString(),
+				plan.ProjectID.ValueString(),
+				describeError(err),
+			),
+		)
+		return
+	}
+
+	result := convertResponseToCustomEnvironment(out, plan)
+	tflog.Trace(ctx, "created custom environment", map[string]interface{}{
+		"team_id":               result.TeamID.ValueString(),
+		"project_id":            result.ProjectID.ValueString(),
+		"custom_environment_id": result.ID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read will read a custom environment of a Vercel project by requesting it from the Vercel API, and will update terraform
+// with this information.
+func (r *customEnvironmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state CustomEnvironment
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := r.client.GetCustomEnvironment(ctx, state.ProjectID.ValueString(), state.TeamID.ValueString(), state.ID.ValueString())
+	if client.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading custom environment",
+			fmt.Sprintf("Could not get custom environment %s %s %s, unexpected error: %s",
+				state.TeamID.ValueString(),
+				state.ProjectID.ValueString(),
+				state.ID.ValueString(),
+				describeError(err),
+			),
+		)
+		return
+	}
+
+	result := convertResponseToCustomEnvironment(out, state)
+	tflog.Trace(ctx, "read custom environment", map[string]interface{}{
+		"team_id":               result.TeamID.ValueString(),
+		"project_id":            result.ProjectID.ValueString(),
+		"custom_environment_id": result.ID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the custom environment via the Vercel API.
+func (r *customEnvironmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state CustomEnvironment
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := r.client.UpdateCustomEnvironment(ctx, plan.toUpdateCustomEnvironmentRequest(state.ID.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating custom environment",
+			fmt.Sprintf(
+				"Could not update custom environment %s for project %s, unexpected error: %s",
+				state.ID.ValueString(),
+				state.ProjectID.ValueString(),
+				describeError(err),
+			),
+		)
+		return
+	}
+
+	result := convertResponseToCustomEnvironment(out, plan)
+	tflog.Trace(ctx, "updated custom environment", map[string]interface{}{
+		"team_id":               result.TeamID.ValueString(),
+		"project_id":            result.ProjectID.ValueString(),
+		"custom_environment_id": result.ID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes a custom environment of a Vercel project. Any environment variables and domains
+// that only apply to the custom environment are removed with it.
+func (r *customEnvironmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state CustomEnvironment
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteCustomEnvironment(ctx, state.ProjectID.ValueString(), state.TeamID.ValueString(), state.ID.ValueString())
+	if client.NotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting custom environment",
+			fmt.Sprintf(
+				"Could not delete custom environment %s, unexpected error: %s",
+				state.ID.ValueString(),
+				describeError(err),
+			),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted custom environment", map[string]interface{}{
+		"team_id":               state.TeamID.ValueString(),
+		"project_id":            state.ProjectID.ValueString(),
+		"custom_environment_id": state.ID.ValueString(),
+	})
+}
+
+// splitCustomEnvironmentID is a helper function for splitting an import ID into the corresponding parts.
+// It also validates whether the ID is in a correct format.
+func splitCustomEnvironmentID(id string) (teamID, projectID, idOrSlug string, ok bool) {
+	attributes := strings.Split(id, "/")
+	if len(attributes) == 2 {
+		// we have project_id/id_or_slug
+		return "", attributes[0], attributes[1], true
+	}
+	if len(attributes) == 3 {
+		// we have team_id/project_id/id_or_slug
+		return attributes[0], attributes[1], attributes[2], true
+	}
+	return "", "", "", false
+}
+
+// ImportState takes an identifier and reads all the custom environment information from the Vercel API.
+// The results are then stored in terraform state.
+func (r *customEnvironmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	teamID, projectID, idOrSlug, ok := splitCustomEnvironmentID(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error importing custom environment",
+			fmt.Sprintf("Invalid id '%s' specified. should be in format \"team_id/project_id/custom_environment_id\" or \"project_id/custom_environment_id\"", req.ID),
+		)
+		return
+	}
+
+	out, err := r.client.GetCustomEnvironment(ctx, projectID, teamID, idOrSlug)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading custom environment",
+			fmt.Sprintf("Could not get custom environment %s %s %s, unexpected error: %s",
+				teamID,
+				projectID,
+				idOrSlug,
+				describeError(err),
+			),
+		)
+		return
+	}
+
+	result := convertResponseToCustomEnvironment(out, CustomEnvironment{})
+	tflog.Trace(ctx, "imported custom environment", map[string]interface{}{
+		"team_id":               result.TeamID.ValueString(),
+		"project_id":            result.ProjectID.ValueString(),
+		"custom_environment_id": result.ID.ValueString(),
+	})
+
+	diags := resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,105 @@
+package vercel
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+// CustomEnvironment reflects the state terraform stores internally for a custom environment.
+type CustomEnvironment struct {
+	ID             types.String    `tfsdk:"id"`
+	TeamID         types.String    `tfsdk:"team_id"`
+	ProjectID      types.String    `tfsdk:"project_id"`
+	Name           types.String    `tfsdk:"name"`
+	Slug           types.String    `tfsdk:"slug"`
+	Description    types.String    `tfsdk:"description"`
+	BranchTracking *BranchTracking `tfsdk:"branch_tracking"`
+	Domains        types.Set       `tfsdk:"domains"`
+}
+
+// BranchTracking reflects the state terraform stores internally for the git branches that are
+// deployed to a custom environment.
+type BranchTracking struct {
+	Pattern types.String `tfsdk:"pattern"`
+	Type    types.String `tfsdk:"type"`
+}
+
+func (b *BranchTracking) toBranchMatcher() *client.BranchMatcher {
+	if b == nil {
+		return nil
+	}
+	return &client.BranchMatcher{
+		Pattern: b.Pattern.ValueString(),
+		Type:    b.Type.ValueString(),
+	}
+}
+
+func (e *CustomEnvironment) toCreateCustomEnvironmentRequest() client.CreateCustomEnvironmentRequest {
+	return client.CreateCustomEnvironmentRequest{
+		Slug:          e.Name.ValueString(),
+		Description:   e.Description.ValueString(),
+		BranchMatcher: e.BranchTracking.toBranchMatcher(),
+		ProjectID:     e.ProjectID.ValueString(),
+		TeamID:        e.TeamID.ValueString(),
+	}
+}
+
+func (e *CustomEnvironment) toUpdateCustomEnvironmentRequest(id string) client.UpdateCustomEnvironmentRequest {
+	return client.UpdateCustomEnvironmentRequest{
+		Slug:          e.Name.ValueString(),
+		Description:   e.Description.ValueString(),
+		BranchMatcher: e.BranchTracking.toBranchMatcher(),
+		ID:            id,
+		ProjectID:     e.ProjectID.ValueString(),
+		TeamID:        e.TeamID.ValueString(),
+	}
+}
+
+var invalidSlugCharacters = regexp.MustCompile(`[^a-z0-9]+`)
+
+// customEnvironmentIDRegex matches the IDs of custom environments. Unlike deployments, environment
+// variables can only reference a custom environment by its ID.
+var customEnvironmentIDRegex = regexp.MustCompile(`^env_[A-Za-z0-9]+$`)
+
+// customEnvironmentSlug returns the slug Vercel derives from the name of a custom environment.
+func customEnvironmentSlug(name string) string {
+	return strings.Trim(invalidSlugCharacters.ReplaceAllString(strings.ToLower(name), "-"), "-")
+}
+
+// convertResponseToCustomEnvironment is used to populate terraform state based on an API response.
+// Vercel only stores the slug of a custom environment, so the name from the prior plan or state is
+// kept as long as it still results in the same slug.
+func convertResponseToCustomEnvironment(response client.CustomEnvironmentResponse, prior CustomEnvironment) CustomEnvironment {
+	name := types.StringValue(response.Slug)
+	if customEnvironmentSlug(prior.Name.ValueString()) == response.Slug {
+		name = prior.Name
+	}
+
+	var branchTracking *BranchTracking
+	if response.BranchMatcher != nil {
+		branchTracking = &BranchTracking{
+			Pattern: types.StringValue(response.BranchMatcher.Pattern),
+			Type:    types.StringValue(response.BranchMatcher.Type),
+		}
+	}
+
+	domains := []attr.Value{}
+	for _, d := range response.Domains {
+		domains = append(domains, types.StringValue(d.Name))
+	}
+
+	return CustomEnvironment{
+		ID:             types.StringValue(response.ID),
+		TeamID:         toTeamID(response.TeamID),
+		ProjectID:      types.StringValue(response.ProjectID),
+		Name:           name,
+		Slug:           types.StringValue(response.Slug),
+		Description:    fromOptionalString(response.Description),
+		BranchTracking: branchTracking,
+		Domains:        types.SetValueMust(types.StringType, domains),
+	}
+}
@@ -0,0 +1,200 @@
+package vercel_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+func testAccCustomEnvironmentExists(n, teamID string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		_, err := testClient().GetCustomEnvironment(context.TODO(), rs.Primary.Attributes["project_id"], teamID, rs.Primary.ID)
+		return err
+	}
+}
+
+func testAccCustomEnvironmentDestroy(n, teamID, slug string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no projectID is set")
+		}
+
+		_, err := testClient().GetCustomEnvironment(context.TODO(), rs.Primary.ID, teamID, slug)
+		if err == nil {
+			return fmt.Errorf("expected not_found error, but got no error")
+		}
+		if !client.NotFound(err) {
+			return fmt.Errorf("Unexpected error checking for deleted custom environment: %s", err)
+		}
+
+		return nil
+	}
+}
+
+func TestAcc_CustomEnvironment(t *testing.T) {
+	nameSuffix := acctest.RandString(16)
+	domain := acctest.RandString(30) + ".vercel.app"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccProjectDestroy("vercel_project.test", testTeam()),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomEnvironmentConfig(nameSuffix, domain, teamIDConfig()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCustomEnvironmentExists("vercel_custom_environment.test", testTeam()),
+					resource.TestCheckResourceAttr("vercel_custom_environment.test", "name", "staging"),
+					resource.TestCheckResourceAttr("vercel_custom_environment.test", "slug", "staging"),
+					resource.TestCheckResourceAttr("vercel_custom_environment.test", "description", "a staging environment"),
+					resource.TestCheckResourceAttr("vercel_custom_environment.test", "branch_tracking.pattern", "release/"),
+					resource.TestCheckResourceAttr("vercel_custom_environment.test", "branch_tracking.type", "startsWith"),
+					resource.TestCheckResourceAttrPair("vercel_project_domain.test", "custom_environment_id", "vercel_custom_environment.test", "id"),
+					resource.TestCheckResourceAttr("vercel_project_environment_variable.test", "target.#", "0"),
+					resource.TestCheckResourceAttrPair("vercel_project_environment_variable.test", "custom_environment_ids.0", "vercel_custom_environment.test", "id"),
+					resource.TestCheckResourceAttrPair("vercel_deployment.test", "custom_environment", "vercel_custom_environment.test", "slug"),
+					resource.TestCheckTypeSetElemAttr("vercel_deployment.test", "domains.*", domain),
+				),
+			},
+			{
+				Config: testAccCustomEnvironmentConfigUpdated(nameSuffix, domain, teamIDConfig()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCustomEnvironmentExists("vercel_custom_environment.test", testTeam()),
+					resource.TestCheckResourceAttr("vercel_custom_environment.test", "name", "QA"),
+					resource.TestCheckResourceAttr("vercel_custom_environment.test", "slug", "qa"),
+					resource.TestCheckNoResourceAttr("vercel_custom_environment.test", "description"),
+					resource.TestCheckNoResourceAttr("vercel_custom_environment.test", "branch_tracking"),
+					resource.TestCheckTypeSetElemAttr("vercel_custom_environment.test", "domains.*", domain),
+				),
+			},
+			{
+				ResourceName:      "vercel_custom_environment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getCustomEnvironmentImportID("vercel_custom_environment.test"),
+				// Vercel only stores the slug, so the name cannot be read back.
+				ImportStateVerifyIgnore: []string{"name"},
+			},
+			{
+				Config: testAccCustomEnvironmentConfigDeleted(nameSuffix, teamIDConfig()),
+				Check:  testAccCustomEnvironmentDestroy("vercel_project.test", testTeam(), "qa"),
+			},
+		},
+	})
+}
+
+func getCustomEnvironmentImportID(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return "", fmt.Errorf("no ID is set")
+		}
+
+		if rs.Primary.Attributes["team_id"] == "" {
+			return fmt.Sprintf("%s/%s", rs.Primary.Attributes["project_id"], rs.Primary.ID), nil
+		}
+		return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["team_id"], rs.Primary.Attributes["project_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccCustomEnvironmentConfig(projectSuffix, domain, teamID string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "test-acc-custom-environment-%[1]s"
+  %[3]s
+}
+
+resource "vercel_custom_environment" "test" {
+  project_id  = vercel_project.test.id
+  %[3]s
+  name        = "staging"
+  description = "a staging environment"
+  branch_tracking = {
+    pattern = "release/"
+  }
+}
+
+resource "vercel_project_domain" "test" {
+  project_id            = vercel_project.test.id
+  %[3]s
+  domain                = "%[2]s"
+  custom_environment_id = vercel_custom_environment.test.id
+}
+
+resource "vercel_project_environment_variable" "test" {
+  project_id             = vercel_project.test.id
+  %[3]s
+  key                    = "foo"
+  value                  = "bar"
+  target                 = []
+  custom_environment_ids = [vercel_custom_environment.test.id]
+}
+
+resource "vercel_deployment" "test" {
+  project_id         = vercel_project.test.id
+  %[3]s
+  files              = data.vercel_file.index.file
+  custom_environment = vercel_custom_environment.test.slug
+  depends_on         = [vercel_project_domain.test]
+}
+
+data "vercel_file" "index" {
+  path = "examples/one/index.html"
+}
+`, projectSuffix, domain, teamID)
+}
+
+func testAccCustomEnvironmentConfigUpdated(projectSuffix, domain, teamID string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "test-acc-custom-environment-%[1]s"
+  %[3]s
+}
+
+resource "vercel_custom_environment" "test" {
+  project_id = vercel_project.test.id
+  %[3]s
+  name       = "QA"
+}
+
+resource "vercel_project_domain" "test" {
+  project_id            = vercel_project.test.id
+  %[3]s
+  domain                = "%[2]s"
+  custom_environment_id = vercel_custom_environment.test.id
+}
+`, projectSuffix, domain, teamID)
+}
+
+func testAccCustomEnvironmentConfigDeleted(projectSuffix, teamID string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "test-acc-custom-environment-%[1]s"
+  %[2]s
+}
+`, projectSuffix, teamID)
+}
@@ -6,8 +6,13 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -59,7 +64,7 @@ func (r *deploymentResource) Configure(ctx context.Context, req resource.Configu
 }
 
 // Schema returns the schema information for a deployment resource.
-func (r *deploymentResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *deploymentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: `
 Provides a Deployment resource.
@@ -70,6 +75,8 @@ When making deployments, the Project will be uploaded and transformed into a pro
 
 Once the build step has completed successfully, a new, immutable deployment will be made available at the preview URL. Deployments are retained indefinitely unless deleted manually.
 
+By default, Terraform will wait up to 60 minutes for a deployment to be uploaded and built. This can be changed with a ` + "`timeouts`" + ` block. If the deployment does not complete in time, or Terraform is interrupted, the deployment is cancelled.
+
 -> In order to provide files to a deployment, you'll need to use the ` + "`vercel_file` or `vercel_project_directory` data sources." + `
 
 ~> If you are creating Deployments through terraform and intend to use both preview and production
@@ -120,6 +127,11 @@ terraform to your Deployment.
 				Computed:      true,
 				PlanModifiers: []planmodifier.Bool{boolplanmodifier.RequiresReplace()},
 			},
+			"custom_environment": schema.StringAttribute{
+				Description:   "The ID or slug of a `vercel_custom_environment` to deploy to. This cannot be used with `production`.",
+				Optional:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
 			"files": schema.MapAttribute{
 				Description:   "A map of files to be uploaded for the deployment. This should be provided by a `vercel_project_directory` or `vercel_file` data source. Required if `git_source` is not set.",
 				Optional:      true,
@@ -173,6 +185,26 @@ terraform to your Deployment.
 				Description: "Set to true to hard delete the Vercel deployment when destroying the Terraform resource. If unspecified, deployments are retained indefinitely. Note that deleted deployments are not recoverable.",
 				Optional:    true,
 			},
+			"upload_concurrency": schema.Int64Attribute{
+				Description: fmt.Sprintf("The maximum number of files to upload to Vercel at once when creating the deployment. Defaults to %d.", defaultUploadConcurrency),
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64GreaterThan(1),
+					int64LessThan(64),
+				},
+			},
+			"build_log_lines": schema.Int64Attribute{
+				Description: fmt.Sprintf("The number of lines from the end of the build log to include in the error if the deployment fails to build. Set to 0 to omit the build log. Defaults to %d.", defaultBuildLogLines),
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64GreaterThan(0),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
 		},
 	}
 }
@@ -200,6 +232,13 @@ func (r *deploymentResource) ValidateConfig(ctx context.Context, req resource.Va
 		)
 		return
 	}
+	if !config.CustomEnvironment.IsNull() && config.Production.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Deployment Invalid",
+			"A Deployment cannot have both `custom_environment` and `production` specified",
+		)
+		return
+	}
 }
 
 func validatePrebuiltBuilds(diags AddErrorer, config Deployment, files []client.DeploymentFile) {
@@ -276,6 +315,14 @@ func (r *deploymentResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultDeploymentCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	var unparsedFiles map[string]string
 	diags = plan.Files.ElementsAs(ctx, &unparsedFiles, false)
 	resp.Diagnostics.Append(diags...)
@@ -303,20 +350,7 @@ func (r *deploymentResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	target := ""
-	if plan.Production.ValueBool() {
-		target = "production"
-	}
-	cdr := client.CreateDeploymentRequest{
-		Files:           files,
-		Environment:     filterNullFromMap(environment),
-		ProjectID:       plan.ProjectID.ValueString(),
-		ProjectSettings: plan.ProjectSettings.toRequest(),
-		Target:          target,
-		Ref:             plan.Ref.ValueString(),
-	}
-
-	_, err = r.client.GetProject(ctx, plan.ProjectID.ValueString(), plan.TeamID.ValueString(), false)
+	project, err := r.client.GetProject(ctx, plan.ProjectID.ValueString(), plan.TeamID.ValueString(), false)
 	if client.NotFound(err) {
 		resp.Diagnostics.AddError(
 			"Error creating deployment",
@@ -324,57 +358,66 @@ func (r *deploymentResource) Create(ctx context.Context, req resource.CreateRequ
 		)
 		return
 	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating deployment",
+			fmt.Sprintf("Could not read project %s, unexpected error: %s", plan.ProjectID.ValueString(), describeError(err)),
+		)
+		return
+	}
+
+	target := ""
+	if plan.Production.ValueBool() {
+		target = "production"
+	}
+	cdr := client.CreateDeploymentRequest{
+		Files:                     files,
+		Environment:               filterNullFromMap(environment),
+		ProjectID:                 plan.ProjectID.ValueString(),
+		ProjectSettings:           plan.ProjectSettings.toRequest(project.SourceFilesOutsideRootDirectory),
+		Target:                    target,
+		Ref:                       plan.Ref.ValueString(),
+		CustomEnvironmentSlugOrID: plan.CustomEnvironment.ValueString(),
+	}
 
 	out, err := r.client.CreateDeployment(ctx, cdr, plan.TeamID.ValueString())
 	var mfErr client.MissingFilesError
 	if errors.As(err, &mfErr) {
 		// Then we need to upload the files, and create the deployment again.
+		var missing []client.DeploymentFile
 		for _, sha := range mfErr.Missing {
-			f := filesBySha[sha]
-			content, err := os.ReadFile(f.File)
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error reading file",
-					fmt.Sprintf(
-						"Could not read file %s, unexpected error: %s",
-						f.File,
-						err,
-					),
-				)
-				return
-			}
-
-			err = r.client.CreateFile(ctx, client.CreateFileRequest{
-				Filename: normaliseFilename(f.File, plan.PathPrefix),
-				SHA:      f.Sha,
-				Content:  string(content),
-				TeamID:   plan.TeamID.ValueString(),
-			})
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error uploading deployment file",
-					fmt.Sprintf(
-						"Could not upload deployment file %s, unexpected error: %s",
-						f.File,
-						err,
-					),
-				)
-				return
-			}
+			missing = append(missing, filesBySha[sha])
 		}
-
-		out, err = r.client.CreateDeployment(ctx, cdr, plan.TeamID.ValueString())
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error creating deployment",
-				"Could not create deployment, unexpected error: "+err.Error(),
-			)
+		resp.Diagnostics.Append(r.uploadFiles(ctx, missing, plan)...)
+		if resp.Diagnostics.HasError() {
 			return
 		}
-	} else if err != nil {
+
+		out, err = r.client.CreateDeployment(ctx, cdr, plan.TeamID.ValueString())
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		resp.Diagnostics.AddError(
+			"Error creating deployment",
+			fmt.Sprintf(
+				"The deployment did not complete within %s, so it has been cancelled. The create timeout can be increased with a `timeouts` block: %s",
+				createTimeout,
+				err,
+			),
+		)
+		return
+	}
+	var failedErr client.DeploymentFailedError
+	if errors.As(err, &failedErr) {
 		resp.Diagnostics.AddError(
 			"Error creating deployment",
-			"Could not create deployment, unexpected error: "+err.Error(),
+			"The deployment failed to build: "+err.Error()+r.buildLogSummary(ctx, failedErr.DeploymentID, plan),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating deployment",
+			"Could not create deployment, unexpected error: "+describeError(err),
 		)
 		return
 	}
@@ -392,6 +435,198 @@ func (r *deploymentResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 }
 
+// defaultDeploymentCreateTimeout is how long to wait for a deployment to be uploaded and built
+// if no create timeout has been configured.
+const defaultDeploymentCreateTimeout = 60 * time.Minute
+
+// defaultUploadConcurrency is the number of files uploaded at once if upload_concurrency is not set.
+const defaultUploadConcurrency = 8
+
+// defaultBuildLogLines is the number of build log lines included in a failed deployment's error if
+// build_log_lines is not set.
+const defaultBuildLogLines = 50
+
+// buildLogSummary fetches the build log for a failed deployment, and formats the last lines of it so
+// they can be appended to a diagnostic. The full log is written to the debug log. As the deployment has
+// already failed, any problem fetching the log is only logged, so the original error is still reported.
+func (r *deploymentResource) buildLogSummary(ctx context.Context, deploymentID string, plan Deployment) string {
+	n := defaultBuildLogLines
+	if !plan.BuildLogLines.IsNull() && !plan.BuildLogLines.IsUnknown() {
+		n = int(plan.BuildLogLines.ValueInt64())
+	}
+	// The create context may have little time left, so give fetching the log its own deadline. It is
+	// still derived from the create context, so that the request is logged with the same logger.
+	logCtx, cancel := context.WithTimeout(detachedContext{ctx}, 30*time.Second)
+	defer cancel()
+	lines, err := r.client.GetDeploymentLogs(logCtx, deploymentID, plan.TeamID.ValueString())
+	if err != nil {
+		tflog.Warn(ctx, "Unable to fetch build logs for failed deployment", map[string]interface{}{
+			"deployment_id": deploymentID,
+			"error":         err.Error(),
+		})
+		return ""
+	}
+	tflog.Debug(ctx, "Build log for failed deployment", map[string]interface{}{
+		"deployment_id": deploymentID,
+		"log":           strings.Join(lines, "\n"),
+	})
+	if n <= 0 || len(lines) == 0 {
+		return ""
+	}
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return fmt.Sprintf("\n\nThe last %d lines of the build log were:\n\n%s", len(lines), strings.Join(lines, "\n"))
+}
+
+// detachedContext keeps the values of a parent context, such as its logger, while ignoring the
+// parent's deadline and cancellation.
+type detachedContext struct {
+	parent context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool)         { return time.Time{}, false }
+func (detachedContext) Done() <-chan struct{}               { return nil }
+func (detachedContext) Err() error                          { return nil }
+func (c detachedContext) Value(key interface{}) interface{} { return c.parent.Value(key) }
+
+// maxUploadErrors limits the number of individual file upload failures that are reported.
+const maxUploadErrors = 10
+
+// uploadFiles uploads a set of files to Vercel using a pool of workers, so that deployments with
+// many files do not need to upload them one at a time. Each upload is retried independently by the
+// client, as uploading a file is idempotent. Rather than stopping at the first failure, every file
+// is attempted and any failures are reported together.
+func (r *deploymentResource) uploadFiles(ctx context.Context, files []client.DeploymentFile, plan Deployment) (diags diag.Diagnostics) {
+	concurrency := defaultUploadConcurrency
+	if !plan.UploadConcurrency.IsNull() && !plan.UploadConcurrency.IsUnknown() {
+		concurrency = int(plan.UploadConcurrency.ValueInt64())
+	}
+	if concurrency > len(files) {
+		concurrency = len(files)
+	}
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	tflog.Info(ctx, "uploading deployment files", map[string]interface{}{
+		"files":       len(files),
+		"concurrency": concurrency,
+	})
+
+	type uploadError struct {
+		file string
+		err  error
+	}
+	var (
+		mu       sync.Mutex
+		failures []uploadError
+		uploaded int
+		wg       sync.WaitGroup
+	)
+	jobs := make(chan client.DeploymentFile)
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for f := range jobs {
+				err := r.uploadFile(ctx, f, plan)
+
+				mu.Lock()
+				if err != nil {
+					failures = append(failures, uploadError{file: f.File, err: err})
+				} else {
+					uploaded++
+					// Log progress roughly every 10% so large deployments don't look stuck.
+					if step := len(files) / 10; step == 0 || uploaded%step == 0 || uploaded == len(files) {
+						tflog.Info(ctx, "uploaded deployment files", map[string]interface{}{
+							"uploaded": uploaded,
+							"total":    len(files),
+						})
+					}
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+send:
+	for _, f := range files {
+		select {
+		case jobs <- f:
+		case <-ctx.Done():
+			break send
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		diags.AddError(
+			"Error uploading deployment files",
+			fmt.Sprintf("Uploading deployment files was interrupted after %d of %d files: %s", uploaded, len(files), err),
+		)
+		return diags
+	}
+
+	sort.Slice(failures, func(i, j int) bool {
+		return failures[i].file < failures[j].file
+	})
+	for i, f := range failures {
+		tflog.Debug(ctx, "failed to upload deployment file", map[string]interface{}{
+			"file":  f.file,
+			"error": f.err.Error(),
+		})
+		if i < maxUploadErrors {
+			diags.AddError(
+				"Error uploading deployment file",
+				fmt.Sprintf(
+					"Could not upload deployment file %s, unexpected error: %s",
+					f.file,
+					describeError(f.err),
+				),
+			)
+		}
+	}
+	if len(failures) > maxUploadErrors {
+		diags.AddError(
+			"Error uploading deployment files",
+			fmt.Sprintf("A further %d files could not be uploaded. Enable debug logging to see every failure.", len(failures)-maxUploadErrors),
+		)
+	}
+	return diags
+}
+
+// uploadFile streams a single file from disk to Vercel. The file is only opened once it is
+// ready to be uploaded, so that only the files currently being uploaded are held open.
+func (r *deploymentResource) uploadFile(ctx context.Context, f client.DeploymentFile, plan Deployment) error {
+	content, err := os.Open(f.File)
+	if err != nil {
+		return fmt.Errorf("could not read file: %w", err)
+	}
+	defer content.Close()
+	info, err := content.Stat()
+	if err != nil {
+		return fmt.Errorf("could not read file: %w", err)
+	}
+
+	err = r.client.CreateFile(ctx, client.CreateFileRequest{
+		Filename: normaliseFilename(f.File, plan.PathPrefix),
+		SHA:      f.Sha,
+		Content:  content,
+		Size:     info.Size(),
+		TeamID:   plan.TeamID.ValueString(),
+	})
+	if err != nil {
+		return err
+	}
+	tflog.Debug(ctx, "uploaded deployment file", map[string]interface{}{
+		"file": f.File,
+		"sha":  f.Sha,
+	})
+	return nil
+}
+
 // Read will read a file from the filesytem and provide terraform with information about it.
 // It is called by the provider whenever data source values should be read to update state.
 func (r *deploymentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -413,7 +648,7 @@ func (r *deploymentResource) Read(ctx context.Context, req resource.ReadRequest,
 			fmt.Sprintf("Could not get deployment %s %s, unexpected error: %s",
 				state.TeamID.ValueString(),
 				state.ID.ValueString(),
-				err,
+				describeError(err),
 			),
 		)
 		return
@@ -433,7 +668,7 @@ func (r *deploymentResource) Read(ctx context.Context, req resource.ReadRequest,
 }
 
 // Update updates the deployment state.
-// Note that only the `delete_on_destroy` field is updatable, and this does not affect Vercel. So it is just a case
+// Note that only the `delete_on_destroy`, `upload_concurrency`, `build_log_lines` and `timeouts` fields are updatable, and this does not affect Vercel. So it is just a case
 // of setting terraform state.
 func (r *deploymentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plan Deployment
@@ -454,8 +689,11 @@ func (r *deploymentResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	// Copy over the planned field only
+	// Copy over the planned fields only
 	state.DeleteOnDestroy = plan.DeleteOnDestroy
+	state.UploadConcurrency = plan.UploadConcurrency
+	state.BuildLogLines = plan.BuildLogLines
+	state.Timeouts = plan.Timeouts
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -482,7 +720,7 @@ func (r *deploymentResource) Delete(ctx context.Context, req resource.DeleteRequ
 				fmt.Sprintf(
 					"Could not delete deployment %s, unexpected error: %s",
 					state.URL.ValueString(),
-					err,
+					describeError(err),
 				),
 			)
 			return
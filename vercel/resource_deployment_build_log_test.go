@@ -0,0 +1,92 @@
+package vercel
+
+import (
+	"context"
+	"crypto/sha1"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vercel/terraform-provider-vercel/client"
+	"github.com/vercel/terraform-provider-vercel/client/clienttest"
+)
+
+func TestBuildLogSummary(t *testing.T) {
+	server := clienttest.NewServer()
+	defer server.Close()
+	c := server.Client()
+	ctx := context.Background()
+
+	project, err := c.CreateProject(ctx, "", client.CreateProjectRequest{Name: "build-log-project"})
+	if err != nil {
+		t.Fatalf("unexpected error creating project: %s", err)
+	}
+	content := "<html></html>"
+	sha := fmt.Sprintf("%x", sha1.Sum([]byte(content)))
+	err = c.CreateFile(ctx, client.CreateFileRequest{
+		Filename: "index.html",
+		SHA:      sha,
+		Content:  strings.NewReader(content),
+		Size:     int64(len(content)),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error uploading file: %s", err)
+	}
+
+	server.SetDeploymentBehaviour(clienttest.DeploymentBehaviour{
+		ReadyState:   "ERROR",
+		ErrorCode:    "BUILD_UTILS_SPAWN_1",
+		ErrorMessage: "Command \"npm run build\" exited with 1",
+		BuildLogs: []string{
+			"Cloning completed",
+			"Running \"npm run build\"",
+			"Error: Cannot find module 'next'",
+			"Error: Command \"npm run build\" exited with 1",
+		},
+	})
+	_, err = c.CreateDeployment(ctx, client.CreateDeploymentRequest{
+		Files: []client.DeploymentFile{
+			{File: "index.html", Sha: sha, Size: len(content)},
+		},
+		ProjectID: project.ID,
+	}, "")
+	var failedErr client.DeploymentFailedError
+	if !errors.As(err, &failedErr) {
+		t.Fatalf("expected a DeploymentFailedError, got %v", err)
+	}
+
+	r := &deploymentResource{client: c}
+	plan := Deployment{
+		TeamID:        types.StringValue(""),
+		BuildLogLines: types.Int64Value(2),
+	}
+
+	// The create context has usually run out of time by the point the log is fetched.
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	summary := r.buildLogSummary(cancelled, failedErr.DeploymentID, plan)
+	expected := "\n\nThe last 2 lines of the build log were:\n\n" +
+		"Error: Cannot find module 'next'\n" +
+		"Error: Command \"npm run build\" exited with 1"
+	if summary != expected {
+		t.Errorf("expected summary %q, got %q", expected, summary)
+	}
+
+	plan.BuildLogLines = types.Int64Value(0)
+	if summary := r.buildLogSummary(ctx, failedErr.DeploymentID, plan); summary != "" {
+		t.Errorf("expected no summary when build_log_lines is 0, got %q", summary)
+	}
+
+	plan.BuildLogLines = types.Int64Value(-1)
+	if summary := r.buildLogSummary(ctx, failedErr.DeploymentID, plan); summary != "" {
+		t.Errorf("expected no summary when build_log_lines is negative, got %q", summary)
+	}
+
+	plan.BuildLogLines = types.Int64Null()
+	summary = r.buildLogSummary(ctx, failedErr.DeploymentID, plan)
+	if !strings.HasPrefix(summary, "\n\nThe last 4 lines of the build log were:") {
+		t.Errorf("expected the whole build log when it is shorter than the default, got %q", summary)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/vercel/terraform-provider-vercel/client"
@@ -23,18 +24,22 @@ type ProjectSettings struct {
 
 // Deployment represents the terraform state for a deployment resource.
 type Deployment struct {
-	Domains         types.List       `tfsdk:"domains"`
-	Environment     types.Map        `tfsdk:"environment"`
-	Files           types.Map        `tfsdk:"files"`
-	ID              types.String     `tfsdk:"id"`
-	Production      types.Bool       `tfsdk:"production"`
-	ProjectID       types.String     `tfsdk:"project_id"`
-	PathPrefix      types.String     `tfsdk:"path_prefix"`
-	ProjectSettings *ProjectSettings `tfsdk:"project_settings"`
-	TeamID          types.String     `tfsdk:"team_id"`
-	URL             types.String     `tfsdk:"url"`
-	DeleteOnDestroy types.Bool       `tfsdk:"delete_on_destroy"`
-	Ref             types.String     `tfsdk:"ref"`
+	CustomEnvironment types.String     `tfsdk:"custom_environment"`
+	Domains           types.List       `tfsdk:"domains"`
+	Environment       types.Map        `tfsdk:"environment"`
+	Files             types.Map        `tfsdk:"files"`
+	ID                types.String     `tfsdk:"id"`
+	Production        types.Bool       `tfsdk:"production"`
+	ProjectID         types.String     `tfsdk:"project_id"`
+	PathPrefix        types.String     `tfsdk:"path_prefix"`
+	ProjectSettings   *ProjectSettings `tfsdk:"project_settings"`
+	TeamID            types.String     `tfsdk:"team_id"`
+	URL               types.String     `tfsdk:"url"`
+	DeleteOnDestroy   types.Bool       `tfsdk:"delete_on_destroy"`
+	Ref               types.String     `tfsdk:"ref"`
+	UploadConcurrency types.Int64      `tfsdk:"upload_concurrency"`
+	BuildLogLines     types.Int64      `tfsdk:"build_log_lines"`
+	Timeouts          timeouts.Value   `tfsdk:"timeouts"`
 }
 
 // setIfNotUnknown is a helper function to set a value in a map if it is not unknown.
@@ -49,17 +54,22 @@ func setIfNotUnknown(m map[string]interface{}, v types.String, name string) {
 }
 
 // toRequest takes a set of ProjectSettings and converts them into the required
-// format for a CreateDeploymentRequest.
-func (p *ProjectSettings) toRequest() map[string]interface{} {
+// format for a CreateDeploymentRequest. The project's own setting for including source
+// files outside the root directory is passed in, as the default is not otherwise applied.
+func (p *ProjectSettings) toRequest(sourceFilesOutsideRootDirectory *bool) map[string]interface{} {
+	/* Source files outside the root directory are required
+	 * for a monorepo style codebase. This allows a root_directory
+	 * to be set, but enables navigating upwards into a parent workspace.
+	 *
+	 * Surprisngly, even though this is the default setting for a project,
+	 * it has to be explicitly passed for each request.
+	 */
+	includeSourceFiles := true
+	if sourceFilesOutsideRootDirectory != nil {
+		includeSourceFiles = *sourceFilesOutsideRootDirectory
+	}
 	res := map[string]interface{}{
-		/* Source files outside the root directory are required
-		 * for a monorepo style codebase. This allows a root_directory
-		 * to be set, but enables navigating upwards into a parent workspace.
-		 *
-		 * Surprisngly, even though this is the default setting for a project,
-		 * it has to be explicitly passed for each request.
-		 */
-		"sourceFilesOutsideRootDirectory": true,
+		"sourceFilesOutsideRootDirectory": includeSourceFiles,
 	}
 	if p == nil {
 		return res
@@ -196,23 +206,37 @@ func convertResponseToDeployment(response client.DeploymentResponse, plan Deploy
 		plan.Files = types.MapNull(types.StringType)
 	}
 
+	// The custom environment can be referenced by either ID or slug, so keep whichever was
+	// configured.
+	customEnvironment := types.StringNull()
+	if response.CustomEnvironment != nil {
+		customEnvironment = types.StringValue(response.CustomEnvironment.ID)
+		if plan.CustomEnvironment.ValueString() == response.CustomEnvironment.Slug {
+			customEnvironment = plan.CustomEnvironment
+		}
+	}
+
 	ref := types.StringNull()
 	if response.GitSource.Ref != "" {
 		ref = types.StringValue(response.GitSource.Ref)
 	}
 
 	return Deployment{
-		Domains:         types.ListValueMust(types.StringType, domains),
-		TeamID:          toTeamID(response.TeamID),
-		Environment:     plan.Environment,
-		ProjectID:       types.StringValue(response.ProjectID),
-		ID:              types.StringValue(response.ID),
-		URL:             types.StringValue(response.URL),
-		Production:      production,
-		Files:           plan.Files,
-		PathPrefix:      fillStringNull(plan.PathPrefix),
-		ProjectSettings: plan.ProjectSettings.fillNulls(),
-		DeleteOnDestroy: plan.DeleteOnDestroy,
-		Ref:             ref,
+		CustomEnvironment: customEnvironment,
+		Domains:           types.ListValueMust(types.StringType, domains),
+		TeamID:            toTeamID(response.TeamID),
+		Environment:       plan.Environment,
+		ProjectID:         types.StringValue(response.ProjectID),
+		ID:                types.StringValue(response.ID),
+		URL:               types.StringValue(response.URL),
+		Production:        production,
+		Files:             plan.Files,
+		PathPrefix:        fillStringNull(plan.PathPrefix),
+		ProjectSettings:   plan.ProjectSettings.fillNulls(),
+		DeleteOnDestroy:   plan.DeleteOnDestroy,
+		Ref:               ref,
+		UploadConcurrency: plan.UploadConcurrency,
+		BuildLogLines:     plan.BuildLogLines,
+		Timeouts:          plan.Timeouts,
 	}
 }
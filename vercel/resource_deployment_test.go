@@ -180,6 +180,85 @@ func TestAcc_DeploymentWithPathPrefix(t *testing.T) {
 	})
 }
 
+func TestAcc_DeploymentWithUploadConcurrency(t *testing.T) {
+	projectSuffix := acctest.RandString(16)
+	deploymentID := ""
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		CheckDestroy:             noopDestroyCheck,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig(projectSuffix, teamIDConfig(), "upload_concurrency = 1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccDeploymentExists("vercel_deployment.test", ""),
+					resource.TestCheckResourceAttr("vercel_deployment.test", "upload_concurrency", "1"),
+					func(s *terraform.State) error {
+						deploymentID = s.RootModule().Resources["vercel_deployment.test"].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				// Changing the concurrency should not cause a new deployment.
+				Config: testAccDeploymentConfig(projectSuffix, teamIDConfig(), "upload_concurrency = 4"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_deployment.test", "upload_concurrency", "4"),
+					func(s *terraform.State) error {
+						id := s.RootModule().Resources["vercel_deployment.test"].Primary.ID
+						if id != deploymentID {
+							return fmt.Errorf("expected deployment %s to be retained, but got %s", deploymentID, id)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_DeploymentWithBuildLogLines(t *testing.T) {
+	projectSuffix := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		CheckDestroy:             noopDestroyCheck,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig(projectSuffix, teamIDConfig(), "build_log_lines = 10"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccDeploymentExists("vercel_deployment.test", ""),
+					resource.TestCheckResourceAttr("vercel_deployment.test", "build_log_lines", "10"),
+				),
+			},
+			{
+				Config: testAccDeploymentConfig(projectSuffix, teamIDConfig(), "build_log_lines = 0"),
+				Check:  resource.TestCheckResourceAttr("vercel_deployment.test", "build_log_lines", "0"),
+			},
+		},
+	})
+}
+
+func TestAcc_DeploymentWithTimeouts(t *testing.T) {
+	projectSuffix := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		CheckDestroy:             noopDestroyCheck,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig(projectSuffix, teamIDConfig(), `timeouts {
+                    create = "20m"
+                }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccDeploymentExists("vercel_deployment.test", ""),
+					resource.TestCheckResourceAttr("vercel_deployment.test", "timeouts.create", "20m"),
+				),
+			},
+		},
+	})
+}
+
 func TestAcc_DeploymentWithDeleteOnDestroy(t *testing.T) {
 	projectSuffix := acctest.RandString(16)
 	extraConfig := "delete_on_destroy = true"
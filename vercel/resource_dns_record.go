@@ -156,47 +156,7 @@ func (r *dnsRecordResource) ValidateConfig(ctx context.Context, req resource.Val
 		return
 	}
 
-	if config.Type.ValueString() == "SRV" && config.SRV == nil {
-		resp.Diagnostics.AddError(
-			"DNS Record Invalid",
-			"A DNS Record type of 'SRV' requires the `srv` attribute to be set",
-		)
-	}
-
-	if config.Type.ValueString() != "SRV" && config.Value.IsNull() {
-		resp.Diagnostics.AddError(
-			"DNS Record Invalid",
-			fmt.Sprintf("The `value` attribute must be set on records of `type` '%s'", config.Type.ValueString()),
-		)
-	}
-
-	if config.Type.ValueString() == "SRV" && !config.Value.IsNull() {
-		resp.Diagnostics.AddError(
-			"DNS Record Invalid",
-			"The `value` attribute should not be set on records of `type` 'SRV'",
-		)
-	}
-
-	if config.Type.ValueString() != "SRV" && config.SRV != nil {
-		resp.Diagnostics.AddError(
-			"DNS Record Invalid",
-			"The `srv` attribute should only be set on records of `type` 'SRV'",
-		)
-	}
-
-	if config.Type.ValueString() != "MX" && !config.MXPriority.IsNull() {
-		resp.Diagnostics.AddError(
-			"DNS Record Invalid",
-			"The `mx_priority` attribute should only be set on records of `type` 'MX'",
-		)
-	}
-
-	if config.Type.ValueString() == "MX" && config.MXPriority.IsNull() {
-		resp.Diagnostics.AddError(
-			"DNS Record Invalid",
-			"A DNS Record type of 'MX' requires the `mx_priority` attribute to be set",
-		)
-	}
+	resp.Diagnostics.Append(config.validate()...)
 }
 
 // Create will create a DNS record within Vercel by calling the Vercel API.
@@ -213,7 +173,7 @@ func (r *dnsRecordResource) Create(ctx context.Context, req resource.CreateReque
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating DNS Record",
-			"Could not create DNS Record, unexpected error: "+err.Error(),
+			"Could not create DNS Record, unexpected error: "+describeError(err),
 		)
 		return
 	}
@@ -260,7 +220,7 @@ func (r *dnsRecordResource) Read(ctx context.Context, req resource.ReadRequest,
 			fmt.Sprintf("Could not read DNS Record %s %s, unexpected error: %s",
 				state.TeamID.ValueString(),
 				state.ID.ValueString(),
-				err,
+				describeError(err),
 			),
 		)
 		return
@@ -316,7 +276,7 @@ func (r *dnsRecordResource) Update(ctx context.Context, req resource.UpdateReque
 				"Could not update DNS Record %s for domain %s, unexpected error: %s",
 				state.ID.ValueString(),
 				state.Domain.ValueString(),
-				err,
+				describeError(err),
 			),
 		)
 		return
@@ -364,7 +324,7 @@ func (r *dnsRecordResource) Delete(ctx context.Context, req resource.DeleteReque
 				"Could not delete DNS Record %s for domain %s, unexpected error: %s",
 				state.ID.ValueString(),
 				state.Domain.ValueString(),
-				err,
+				describeError(err),
 			),
 		)
 		return
@@ -394,7 +354,7 @@ func (r *dnsRecordResource) ImportState(ctx context.Context, req resource.Import
 			fmt.Sprintf("Could not get DNS Record %s %s, unexpected error: %s",
 				teamID,
 				recordID,
-				err,
+				describeError(err),
 			),
 		)
 		return
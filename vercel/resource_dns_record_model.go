@@ -5,6 +5,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/vercel/terraform-provider-vercel/client"
 )
@@ -30,6 +31,74 @@ type DNSRecord struct {
 	Value      types.String `tfsdk:"value"`
 }
 
+// validate checks that the fields set on a DNS record are consistent with its type.
+func (d DNSRecord) validate() (diags diag.Diagnostics) {
+	if d.Type.ValueString() == "SRV" && d.SRV == nil {
+		diags.AddError(
+			"DNS Record Invalid",
+			"A DNS Record type of 'SRV' requires the `srv` attribute to be set",
+		)
+	}
+
+	if d.Type.ValueString() != "SRV" && d.Value.IsNull() {
+		diags.AddError(
+			"DNS Record Invalid",
+			fmt.Sprintf("The `value` attribute must be set on records of `type` '%s'", d.Type.ValueString()),
+		)
+	}
+
+	if d.Type.ValueString() == "SRV" && !d.Value.IsNull() {
+		diags.AddError(
+			"DNS Record Invalid",
+			"The `value` attribute should not be set on records of `type` 'SRV'",
+		)
+	}
+
+	if d.Type.ValueString() != "SRV" && d.SRV != nil {
+		diags.AddError(
+			"DNS Record Invalid",
+			"The `srv` attribute should only be set on records of `type` 'SRV'",
+		)
+	}
+
+	if d.Type.ValueString() != "MX" && !d.MXPriority.IsNull() {
+		diags.AddError(
+			"DNS Record Invalid",
+			"The `mx_priority` attribute should only be set on records of `type` 'MX'",
+		)
+	}
+
+	if d.Type.ValueString() == "MX" && d.MXPriority.IsNull() {
+		diags.AddError(
+			"DNS Record Invalid",
+			"A DNS Record type of 'MX' requires the `mx_priority` attribute to be set",
+		)
+	}
+	return diags
+}
+
+// identity returns a key that identifies the record within a domain, regardless of its ID or TTL.
+// Trailing dots are ignored, as Vercel may add them to hostnames.
+func (d DNSRecord) identity() string {
+	srv := ""
+	if d.SRV != nil {
+		srv = fmt.Sprintf(
+			"%d %d %d %s",
+			d.SRV.Priority.ValueInt64(),
+			d.SRV.Weight.ValueInt64(),
+			d.SRV.Port.ValueInt64(),
+			strings.TrimSuffix(d.SRV.Target.ValueString(), "."),
+		)
+	}
+	return strings.Join([]string{
+		d.Name.ValueString(),
+		d.Type.ValueString(),
+		strings.TrimSuffix(d.Value.ValueString(), "."),
+		fmt.Sprint(d.MXPriority.ValueInt64()),
+		srv,
+	}, "|")
+}
+
 func (d DNSRecord) toCreateDNSRecordRequest() client.CreateDNSRecordRequest {
 	var srv *client.SRV = nil
 	if d.Type.ValueString() == "SRV" {
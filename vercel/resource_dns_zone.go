@@ -0,0 +1,576 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+var (
+	_ resource.Resource                   = &dnsZoneResource{}
+	_ resource.ResourceWithConfigure      = &dnsZoneResource{}
+	_ resource.ResourceWithValidateConfig = &dnsZoneResource{}
+	_ resource.ResourceWithImportState    = &dnsZoneResource{}
+	_ resource.ResourceWithModifyPlan     = &dnsZoneResource{}
+)
+
+func newDNSZoneResource() resource.Resource {
+	return &dnsZoneResource{}
+}
+
+type dnsZoneResource struct {
+	client *client.Client
+}
+
+func (r *dnsZoneResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zone"
+}
+
+func (r *dnsZoneResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *dnsZoneResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides a DNS Zone resource.
+
+A DNS Zone manages the full set of DNS records for a domain, rather than a single record at a time. Any changes made to the records outside of Terraform will be detected.
+
+By default, records within the domain that are not part of the zone are left untouched. If ` + "`delete_unmanaged`" + ` is set, these records will be deleted instead. The ` + "`exclude`" + ` attribute can be used to ignore records, such as those created automatically by Vercel.
+
+The records can be specified either with the ` + "`records`" + ` attribute, or as a BIND zone file with the ` + "`zone_file`" + ` attribute. A zone file can be used to migrate a domain from another DNS provider, and the ` + "`vercel_dns_zone_file`" + ` data source can be used to export the records of a domain as a zone file.
+
+~> A DNS Zone should not be used alongside ` + "`vercel_dns_record`" + ` resources for the same domain when ` + "`delete_unmanaged`" + ` is set, as the records would be deleted.
+
+For more detailed information, please see the [Vercel documentation](https://vercel.com/docs/concepts/projects/custom-domains#dns-records)
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"team_id": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "The team ID that the domain and DNS records belong to. Required when configuring a team resource if a default team has not been set in the provider.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace(), stringplanmodifier.UseStateForUnknown()},
+			},
+			"domain": schema.StringAttribute{
+				Description:   "The domain name, or zone, that the DNS records belong to.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"delete_unmanaged": schema.BoolAttribute{
+				Description: "Whether records within the domain that are not part of the zone, and are not excluded, should be deleted. Defaults to `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"exclude": schema.SingleNestedAttribute{
+				Description: "Records that should be ignored by the zone. Excluded records are never deleted, and are not reported as drift.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"system": schema.BoolAttribute{
+						Description: "Whether to exclude records that were created automatically by Vercel.",
+						Optional:    true,
+					},
+					"names": schema.SetAttribute{
+						Description: "The subdomain names of records to exclude. Use an empty string for records on the root domain.",
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+					"types": schema.SetAttribute{
+						Description: "The types of records to exclude.",
+						Optional:    true,
+						ElementType: types.StringType,
+						Validators: []validator.Set{
+							stringSetItemsIn("A", "AAAA", "ALIAS", "CAA", "CNAME", "MX", "NS", "SRV", "TXT"),
+						},
+					},
+				},
+			},
+			"zone_file": schema.StringAttribute{
+				Description: "The DNS records within the zone, in BIND zone file format. The `A`, `AAAA`, `ALIAS`, `CAA`, `CNAME`, `MX`, `NS`, `SRV` and `TXT` record types are supported, and `SOA` records are ignored. Exactly one of `records` or `zone_file` must be specified.",
+				Optional:    true,
+			},
+			"records": schema.SetNestedAttribute{
+				Description: "The DNS records within the zone. Exactly one of `records` or `zone_file` must be specified. If `zone_file` is used, this contains the records parsed from it.",
+				Optional:    true,
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The subdomain name of the record. This should be an empty string if the record is for the root domain.",
+							Required:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The type of DNS record. Available types: " + "`A`" + ", " + "`AAAA`" + ", " + "`ALIAS`" + ", " + "`CAA`" + ", " + "`CNAME`" + ", " + "`MX`" + ", " + "`NS`" + ", " + "`SRV`" + ", " + "`TXT`" + ".",
+							Required:    true,
+							Validators: []validator.String{
+								stringOneOf("A", "AAAA", "ALIAS", "CAA", "CNAME", "MX", "NS", "SRV", "TXT"),
+							},
+						},
+						"value": schema.StringAttribute{
+							Description: "The value of the DNS record. The format depends on the 'type' property, and it must be specified on all record types except 'SRV'.",
+							Optional:    true,
+						},
+						"ttl": schema.Int64Attribute{
+							Description: "The TTL value in seconds. Must be a number between 60 and 2147483647. If unspecified, it will default to 60 seconds.",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64GreaterThan(60),
+								int64LessThan(2147483647),
+							},
+						},
+						"mx_priority": schema.Int64Attribute{
+							Description: "The priority of the MX record. A smaller value indicates a higher priority. Required for MX records.",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64GreaterThan(0),
+								int64LessThan(65535),
+							},
+						},
+						"srv": schema.SingleNestedAttribute{
+							Description: "Settings for an SRV record. Required for SRV records.",
+							Optional:    true,
+							Attributes: map[string]schema.Attribute{
+								"weight": schema.Int64Attribute{
+									Description: "A relative weight for records with the same priority, higher value means higher chance of getting picked.",
+									Required:    true,
+									Validators: []validator.Int64{
+										int64GreaterThan(0),
+										int64LessThan(65535),
+									},
+								},
+								"port": schema.Int64Attribute{
+									Description: "The TCP or UDP port on which the service is to be found.",
+									Required:    true,
+									Validators: []validator.Int64{
+										int64GreaterThan(0),
+										int64LessThan(65535),
+									},
+								},
+								"priority": schema.Int64Attribute{
+									Description: "The priority of the target host, lower value means more preferred.",
+									Required:    true,
+									Validators: []validator.Int64{
+										int64GreaterThan(0),
+										int64LessThan(65535),
+									},
+								},
+								"target": schema.StringAttribute{
+									Description: "The canonical hostname of the machine providing the service, ending in a dot.",
+									Required:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig validates the Resource configuration.
+func (r *dnsZoneResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config DNSZone
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if config.Records.IsNull() == config.ZoneFile.IsNull() {
+		resp.Diagnostics.AddError(
+			"DNS Zone Invalid",
+			"Exactly one of `records` or `zone_file` must be specified",
+		)
+		return
+	}
+
+	if !config.ZoneFile.IsNull() {
+		if config.ZoneFile.IsUnknown() || config.Domain.IsUnknown() {
+			return
+		}
+		var err error
+		config.Records, err = parseZoneFileRecords(ctx, config.Domain.ValueString(), config.ZoneFile.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("zone_file"),
+				"DNS Zone Invalid",
+				"Could not parse zone file: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	records, diags := config.records(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(validateZoneRecords(records)...)
+}
+
+// ModifyPlan sets the records of the zone from the zone file, if one is used, so that the planned
+// changes to individual records can be seen.
+func (r *dnsZoneResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		// The zone is being destroyed.
+		return
+	}
+	var plan DNSZone
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.ZoneFile.IsNull() || plan.ZoneFile.IsUnknown() || plan.Domain.IsUnknown() {
+		return
+	}
+
+	records, err := parseZoneFileRecords(ctx, plan.Domain.ValueString(), plan.ZoneFile.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("zone_file"),
+			"DNS Zone Invalid",
+			"Could not parse zone file: "+err.Error(),
+		)
+		return
+	}
+	diags = resp.Plan.SetAttribute(ctx, path.Root("records"), records)
+	resp.Diagnostics.Append(diags...)
+}
+
+// apply makes the records within the domain match the plan. Records that were part of the prior
+// zone, or all records if unmanaged records should be deleted, are removed if they are no longer
+// required. Existing records that match the plan are adopted rather than recreated.
+func (r *dnsZoneResource) apply(ctx context.Context, plan, prior DNSZone) (diags diag.Diagnostics) {
+	domain := plan.Domain.ValueString()
+	teamID := plan.TeamID.ValueString()
+	desired, diags := plan.records(ctx)
+	if diags.HasError() {
+		return diags
+	}
+	previous, diags := prior.records(ctx)
+	if diags.HasError() {
+		return diags
+	}
+	wasManaged := map[string]bool{}
+	for _, p := range previous {
+		wasManaged[p.identity()] = true
+	}
+
+	existing, err := r.client.ListDNSRecords(ctx, domain, teamID, client.ListFilters{})
+	if err != nil {
+		diags.AddError(
+			"Error reading DNS Zone",
+			fmt.Sprintf("Could not list DNS Records for domain %s, unexpected error: %s", domain, describeError(err)),
+		)
+		return diags
+	}
+	byIdentity := map[string][]client.DNSRecord{}
+	for _, e := range existing {
+		if plan.Exclude.excludes(e) {
+			continue
+		}
+		record, err := convertResponseToDNSRecord(e, types.StringNull(), nil)
+		if err != nil {
+			diags.AddError(
+				"Error parsing DNS Record response",
+				fmt.Sprintf("Could not parse DNS Record %s, unexpected error: %s", e.ID, err),
+			)
+			return diags
+		}
+		byIdentity[record.identity()] = append(byIdentity[record.identity()], e)
+	}
+
+	var toCreate []DNSRecord
+	toUpdate := map[string]int64{}
+	for _, d := range desired {
+		matches := byIdentity[d.identity()]
+		if len(matches) == 0 {
+			toCreate = append(toCreate, d)
+			continue
+		}
+		if matches[0].TTL != desiredTTL(d) {
+			toUpdate[matches[0].ID] = desiredTTL(d)
+		}
+		byIdentity[d.identity()] = matches[1:]
+	}
+
+	// Records are deleted first, so that replacing a record that must be unique, such as a CNAME,
+	// does not conflict.
+	for identity, remaining := range byIdentity {
+		if !plan.DeleteUnmanaged.ValueBool() && !wasManaged[identity] {
+			continue
+		}
+		for _, e := range remaining {
+			err := r.client.DeleteDNSRecord(ctx, domain, e.ID, teamID)
+			if err != nil && !client.NotFound(err) {
+				diags.AddError(
+					"Error deleting DNS Record",
+					fmt.Sprintf("Could not delete DNS Record %s for domain %s, unexpected error: %s", e.ID, domain, describeError(err)),
+				)
+				return diags
+			}
+			tflog.Trace(ctx, "deleted DNS zone record", map[string]interface{}{
+				"domain":    domain,
+				"record_id": e.ID,
+			})
+		}
+	}
+	for id, ttl := range toUpdate {
+		_, err := r.client.UpdateDNSRecord(ctx, teamID, id, client.UpdateDNSRecordRequest{TTL: toPtr(ttl)})
+		if err != nil {
+			diags.AddError(
+				"Error updating DNS Record",
+				fmt.Sprintf("Could not update DNS Record %s for domain %s, unexpected error: %s", id, domain, describeError(err)),
+			)
+			return diags
+		}
+	}
+	for _, d := range toCreate {
+		out, err := r.client.CreateDNSRecord(ctx, teamID, d.toCreateDNSRecordRequest())
+		if err != nil {
+			diags.AddError(
+				"Error creating DNS Record",
+				fmt.Sprintf(
+					"Could not create %s record '%s' for domain %s, unexpected error: %s",
+					d.Type.ValueString(),
+					d.Name.ValueString(),
+					domain,
+					describeError(err),
+				),
+			)
+			return diags
+		}
+		tflog.Trace(ctx, "created DNS zone record", map[string]interface{}{
+			"domain":    domain,
+			"record_id": out.ID,
+		})
+	}
+	return diags
+}
+
+// read builds the state of the zone from the records that currently exist within the domain.
+func (r *dnsZoneResource) read(ctx context.Context, prior DNSZone) (DNSZone, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	out, err := r.client.ListDNSRecords(ctx, prior.Domain.ValueString(), prior.TeamID.ValueString(), client.ListFilters{})
+	if err != nil {
+		diags.AddError(
+			"Error reading DNS Zone",
+			fmt.Sprintf("Could not list DNS Records for domain %s, unexpected error: %s",
+				prior.Domain.ValueString(),
+				describeError(err),
+			),
+		)
+		return prior, diags
+	}
+	return convertResponseToDNSZone(ctx, out, r.client.TeamID(prior.TeamID.ValueString()), prior)
+}
+
+// Create will create the records of a DNS zone within Vercel by calling the Vercel API.
+// This is called automatically by the provider when a new resource should be created.
+func (r *dnsZoneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DNSZone
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, plan, DNSZone{Records: types.SetNull(dnsZoneRecordElemType)})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, diags := r.read(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Trace(ctx, "created DNS zone", map[string]interface{}{
+		"team_id": result.TeamID.ValueString(),
+		"domain":  result.Domain.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read will read the records of a DNS zone from the vercel API and provide terraform with information about it.
+// It is called by the provider whenever values should be read to update state.
+func (r *dnsZoneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DNSZone
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.GetDomain(ctx, state.Domain.ValueString(), state.TeamID.ValueString())
+	if client.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading DNS Zone",
+			fmt.Sprintf("Could not read domain %s, unexpected error: %s",
+				state.Domain.ValueString(),
+				describeError(err),
+			),
+		)
+		return
+	}
+
+	result, diags := r.read(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Trace(ctx, "read DNS zone", map[string]interface{}{
+		"team_id": result.TeamID.ValueString(),
+		"domain":  result.Domain.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update will update the records of a DNS zone via the vercel API.
+func (r *dnsZoneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan DNSZone
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state DNSZone
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, plan, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, diags := r.read(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Trace(ctx, "updated DNS zone", map[string]interface{}{
+		"team_id": result.TeamID.ValueString(),
+		"domain":  result.Domain.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete will remove the records of a DNS zone via the Vercel API. Only the records that are part
+// of the zone are deleted, even if unmanaged records would otherwise be deleted.
+func (r *dnsZoneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state DNSZone
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.GetDomain(ctx, state.Domain.ValueString(), state.TeamID.ValueString())
+	if client.NotFound(err) {
+		// The domain is already gone, and its records with it - do nothing.
+		return
+	}
+
+	plan := state
+	plan.Records = types.SetValueMust(dnsZoneRecordElemType, nil)
+	plan.DeleteUnmanaged = types.BoolValue(false)
+	resp.Diagnostics.Append(r.apply(ctx, plan, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "deleted DNS zone", map[string]interface{}{
+		"team_id": state.TeamID.ValueString(),
+		"domain":  state.Domain.ValueString(),
+	})
+}
+
+// ImportState takes an identifier and reads all the records within a domain from the Vercel API.
+// Any records that should not be managed can then be excluded from the configuration.
+func (r *dnsZoneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	teamID, domain, ok := splitID(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error importing DNS Zone",
+			fmt.Sprintf("Invalid id '%s' specified. should be in format \"team_id/domain\" or \"domain\"", req.ID),
+		)
+		return
+	}
+
+	result, diags := r.read(ctx, DNSZone{
+		TeamID:          types.StringValue(teamID),
+		Domain:          types.StringValue(domain),
+		Records:         types.SetNull(dnsZoneRecordElemType),
+		ZoneFile:        types.StringNull(),
+		DeleteUnmanaged: types.BoolValue(true),
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Trace(ctx, "imported DNS zone", map[string]interface{}{
+		"team_id": result.TeamID.ValueString(),
+		"domain":  result.Domain.ValueString(),
+	})
+
+	// All records are imported, but unmanaged records are only deleted if configured to be.
+	result.DeleteUnmanaged = types.BoolValue(false)
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,248 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+// DNSZone reflects the state terraform stores internally for a DNS zone.
+type DNSZone struct {
+	ID              types.String    `tfsdk:"id"`
+	TeamID          types.String    `tfsdk:"team_id"`
+	Domain          types.String    `tfsdk:"domain"`
+	Records         types.Set       `tfsdk:"records"`
+	ZoneFile        types.String    `tfsdk:"zone_file"`
+	DeleteUnmanaged types.Bool      `tfsdk:"delete_unmanaged"`
+	Exclude         *DNSZoneExclude `tfsdk:"exclude"`
+}
+
+// DNSZoneExclude reflects the filter used to ignore records within a DNS zone.
+type DNSZoneExclude struct {
+	System types.Bool     `tfsdk:"system"`
+	Names  []types.String `tfsdk:"names"`
+	Types  []types.String `tfsdk:"types"`
+}
+
+// DNSZoneRecord reflects the state terraform stores internally for a record within a DNS zone.
+// It mirrors the fields of a DNSRecord that are not specific to the zone.
+type DNSZoneRecord struct {
+	Name       types.String `tfsdk:"name"`
+	Type       types.String `tfsdk:"type"`
+	Value      types.String `tfsdk:"value"`
+	TTL        types.Int64  `tfsdk:"ttl"`
+	MXPriority types.Int64  `tfsdk:"mx_priority"`
+	SRV        *SRV         `tfsdk:"srv"`
+}
+
+var dnsZoneRecordElemType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name":        types.StringType,
+		"type":        types.StringType,
+		"value":       types.StringType,
+		"ttl":         types.Int64Type,
+		"mx_priority": types.Int64Type,
+		"srv": types.ObjectType{
+			AttrTypes: map[string]attr.Type{
+				"port":     types.Int64Type,
+				"priority": types.Int64Type,
+				"target":   types.StringType,
+				"weight":   types.Int64Type,
+			},
+		},
+	},
+}
+
+// defaultDNSRecordTTL is the TTL Vercel gives to DNS records if one is not specified.
+const defaultDNSRecordTTL = 60
+
+// excludes returns whether a record should be ignored by the zone.
+func (e *DNSZoneExclude) excludes(r client.DNSRecord) bool {
+	if e == nil {
+		return false
+	}
+	if e.System.ValueBool() && r.Creator == "system" {
+		return true
+	}
+	for _, n := range e.Names {
+		if n.ValueString() == r.Name {
+			return true
+		}
+	}
+	for _, t := range e.Types {
+		if t.ValueString() == r.RecordType {
+			return true
+		}
+	}
+	return false
+}
+
+// records returns the records of the zone as DNSRecords.
+func (z DNSZone) records(ctx context.Context) ([]DNSRecord, diag.Diagnostics) {
+	var zoneRecords []DNSZoneRecord
+	if z.Records.IsNull() || z.Records.IsUnknown() {
+		return nil, nil
+	}
+	diags := z.Records.ElementsAs(ctx, &zoneRecords, false)
+	var records []DNSRecord
+	for _, r := range zoneRecords {
+		records = append(records, DNSRecord{
+			ID:         types.StringNull(),
+			Domain:     z.Domain,
+			MXPriority: r.MXPriority,
+			Name:       r.Name,
+			SRV:        r.SRV,
+			TTL:        r.TTL,
+			TeamID:     z.TeamID,
+			Type:       r.Type,
+			Value:      r.Value,
+		})
+	}
+	return records, diags
+}
+
+// validateZoneRecords checks that each record within a zone is valid, and that no record is
+// specified more than once.
+func validateZoneRecords(records []DNSRecord) (diags diag.Diagnostics) {
+	seen := map[string]bool{}
+	for _, record := range records {
+		diags.Append(record.validate()...)
+		if record.Name.IsUnknown() || record.Type.IsUnknown() || record.Value.IsUnknown() {
+			continue
+		}
+		if seen[record.identity()] {
+			diags.AddError(
+				"DNS Zone Invalid",
+				fmt.Sprintf("The %s record '%s' is specified more than once", record.Type.ValueString(), record.Name.ValueString()),
+			)
+		}
+		seen[record.identity()] = true
+	}
+	return diags
+}
+
+// parseZoneFileRecords parses a BIND zone file into the records of a zone.
+func parseZoneFileRecords(ctx context.Context, domain, content string) (types.Set, error) {
+	parsed, err := client.ParseZoneFile(domain, content)
+	if err != nil {
+		return types.SetNull(dnsZoneRecordElemType), err
+	}
+	records := []DNSZoneRecord{}
+	for _, p := range parsed {
+		record := DNSZoneRecord{
+			Name:       types.StringValue(p.Name),
+			Type:       types.StringValue(p.Type),
+			Value:      types.StringValue(p.Value),
+			TTL:        types.Int64Null(),
+			MXPriority: types.Int64Null(),
+		}
+		if p.TTL != 0 {
+			record.TTL = types.Int64Value(p.TTL)
+		}
+		if p.Type == "MX" {
+			record.MXPriority = types.Int64Value(p.MXPriority)
+		}
+		if p.SRV != nil {
+			record.Value = types.StringNull()
+			record.SRV = &SRV{
+				Port:     types.Int64Value(p.SRV.Port),
+				Priority: types.Int64Value(p.SRV.Priority),
+				Target:   types.StringValue(p.SRV.Target),
+				Weight:   types.Int64Value(p.SRV.Weight),
+			}
+		}
+		records = append(records, record)
+	}
+	set, diags := types.SetValueFrom(ctx, dnsZoneRecordElemType, records)
+	if diags.HasError() {
+		return set, fmt.Errorf("could not convert zone file records")
+	}
+	return set, nil
+}
+
+// desiredTTL returns the TTL a record should have, accounting for the default Vercel applies.
+func desiredTTL(r DNSRecord) int64 {
+	if r.TTL.IsNull() || r.TTL.IsUnknown() {
+		return defaultDNSRecordTTL
+	}
+	return r.TTL.ValueInt64()
+}
+
+// convertResponseToDNSZone builds the state of a zone from the records that exist within the
+// domain. Records that are not part of the prior zone are only included if unmanaged records should
+// be deleted, so that they appear as drift.
+func convertResponseToDNSZone(ctx context.Context, response []client.DNSRecord, teamID string, prior DNSZone) (DNSZone, diag.Diagnostics) {
+	priorRecords, diags := prior.records(ctx)
+	if diags.HasError() {
+		return prior, diags
+	}
+	managed := map[string]DNSRecord{}
+	for _, r := range priorRecords {
+		managed[r.identity()] = r
+	}
+
+	seen := map[string]bool{}
+	records := []DNSZoneRecord{}
+	for _, r := range response {
+		if prior.Exclude.excludes(r) {
+			continue
+		}
+		record, err := convertResponseToDNSRecord(r, types.StringNull(), nil)
+		if err != nil {
+			diags.AddError(
+				"Error parsing DNS Record response",
+				fmt.Sprintf("Could not parse DNS Record %s, unexpected error: %s", r.ID, err),
+			)
+			return prior, diags
+		}
+		identity := record.identity()
+		if seen[identity] {
+			continue
+		}
+		p, ok := managed[identity]
+		if !ok && !prior.DeleteUnmanaged.ValueBool() {
+			continue
+		}
+		if ok {
+			// The identities match, so the value and target only differ by a trailing dot. Keep the
+			// formatting from the configuration.
+			if record.SRV != nil && p.SRV != nil {
+				record.SRV.Target = p.SRV.Target
+			} else {
+				record.Value = p.Value
+			}
+			if p.TTL.IsNull() && record.TTL.ValueInt64() == defaultDNSRecordTTL {
+				record.TTL = types.Int64Null()
+			}
+		}
+		seen[identity] = true
+		records = append(records, DNSZoneRecord{
+			Name:       record.Name,
+			Type:       record.Type,
+			Value:      record.Value,
+			TTL:        record.TTL,
+			MXPriority: record.MXPriority,
+			SRV:        record.SRV,
+		})
+	}
+
+	set, setDiags := types.SetValueFrom(ctx, dnsZoneRecordElemType, records)
+	diags.Append(setDiags...)
+	deleteUnmanaged := prior.DeleteUnmanaged
+	if deleteUnmanaged.IsNull() || deleteUnmanaged.IsUnknown() {
+		deleteUnmanaged = types.BoolValue(false)
+	}
+	return DNSZone{
+		ID:              prior.Domain,
+		TeamID:          toTeamID(teamID),
+		Domain:          prior.Domain,
+		Records:         set,
+		ZoneFile:        prior.ZoneFile,
+		DeleteUnmanaged: deleteUnmanaged,
+		Exclude:         prior.Exclude,
+	}, diags
+}
@@ -0,0 +1,221 @@
+package vercel_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+func testAccDNSZoneRecordCount(domain, name string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		records, err := testClient().ListDNSRecords(context.TODO(), domain, "", client.ListFilters{})
+		if err != nil {
+			return err
+		}
+		count := 0
+		for _, r := range records {
+			if r.Name == name {
+				count++
+			}
+		}
+		if count != expected {
+			return fmt.Errorf("expected %d records named %s, but found %d", expected, name, count)
+		}
+		return nil
+	}
+}
+
+func deleteDNSRecordsNamed(t *testing.T, domain, name string) {
+	records, err := testClient().ListDNSRecords(context.TODO(), domain, "", client.ListFilters{})
+	if err != nil {
+		t.Fatalf("could not list DNS records: %s", err)
+	}
+	for _, r := range records {
+		if r.Name != name {
+			continue
+		}
+		if err := testClient().DeleteDNSRecord(context.TODO(), domain, r.ID, ""); err != nil {
+			t.Fatalf("could not delete DNS record %s: %s", r.ID, err)
+		}
+	}
+}
+
+func TestAcc_DNSZone(t *testing.T) {
+	t.Skip("Skipping until i have a domain in a suitable location to test with")
+	nameSuffix := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccDNSZoneRecordCount(testDomain(), "a-"+nameSuffix, 0),
+			testAccDNSZoneRecordCount(testDomain(), "mx-"+nameSuffix, 0),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSZoneConfig(testDomain(), nameSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_dns_zone.test", "domain", testDomain()),
+					resource.TestCheckResourceAttr("vercel_dns_zone.test", "delete_unmanaged", "false"),
+					resource.TestCheckResourceAttr("vercel_dns_zone.test", "records.#", "4"),
+					testAccDNSZoneRecordCount(testDomain(), "a-"+nameSuffix, 1),
+					testAccDNSZoneRecordCount(testDomain(), "mx-"+nameSuffix, 1),
+					testAccDNSZoneRecordCount(testDomain(), "srv-"+nameSuffix, 1),
+					testAccDNSZoneRecordCount(testDomain(), "txt-"+nameSuffix, 1),
+				),
+			},
+			{
+				// Deleting a record outside of terraform should be detected as drift.
+				PreConfig: func() {
+					deleteDNSRecordsNamed(t, testDomain(), "txt-"+nameSuffix)
+				},
+				Config:             testAccDNSZoneConfig(testDomain(), nameSuffix),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccDNSZoneConfigUpdated(testDomain(), nameSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_dns_zone.test", "records.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs("vercel_dns_zone.test", "records.*", map[string]string{
+						"name":  "a-" + nameSuffix,
+						"type":  "A",
+						"value": "192.168.0.1",
+						"ttl":   "120",
+					}),
+					testAccDNSZoneRecordCount(testDomain(), "a-"+nameSuffix, 1),
+					testAccDNSZoneRecordCount(testDomain(), "mx-"+nameSuffix, 0),
+					testAccDNSZoneRecordCount(testDomain(), "txt-"+nameSuffix, 1),
+				),
+			},
+		},
+	})
+}
+
+func testAccDNSZoneConfig(testDomain, nameSuffix string) string {
+	return fmt.Sprintf(`
+resource "vercel_dns_zone" "test" {
+  domain = "%[1]s"
+
+  records = [
+    {
+      name  = "a-%[2]s"
+      type  = "A"
+      value = "127.0.0.1"
+    },
+    {
+      name        = "mx-%[2]s"
+      type        = "MX"
+      value       = "example.com."
+      mx_priority = 10
+    },
+    {
+      name = "srv-%[2]s"
+      type = "SRV"
+      srv = {
+        port     = 5000
+        weight   = 120
+        priority = 27
+        target   = "example.com."
+      }
+    },
+    {
+      name  = "txt-%[2]s"
+      type  = "TXT"
+      value = "terraform testing"
+    },
+  ]
+}
+`, testDomain, nameSuffix)
+}
+
+func testAccDNSZoneConfigUpdated(testDomain, nameSuffix string) string {
+	return fmt.Sprintf(`
+resource "vercel_dns_zone" "test" {
+  domain = "%[1]s"
+
+  records = [
+    {
+      name  = "a-%[2]s"
+      type  = "A"
+      value = "192.168.0.1"
+      ttl   = 120
+    },
+    {
+      name = "srv-%[2]s"
+      type = "SRV"
+      srv = {
+        port     = 5000
+        weight   = 120
+        priority = 27
+        target   = "example.com."
+      }
+    },
+    {
+      name  = "txt-%[2]s"
+      type  = "TXT"
+      value = "terraform testing"
+    },
+  ]
+}
+`, testDomain, nameSuffix)
+}
+
+func TestAcc_DNSZoneFile(t *testing.T) {
+	t.Skip("Skipping until i have a domain in a suitable location to test with")
+	nameSuffix := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccDNSZoneRecordCount(testDomain(), "a-"+nameSuffix, 0),
+			testAccDNSZoneRecordCount(testDomain(), "mx-"+nameSuffix, 0),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSZoneFileConfig(testDomain(), nameSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_dns_zone.test", "records.#", "4"),
+					resource.TestCheckTypeSetElemNestedAttrs("vercel_dns_zone.test", "records.*", map[string]string{
+						"name":        "mx-" + nameSuffix,
+						"type":        "MX",
+						"value":       "mail." + testDomain() + ".",
+						"mx_priority": "10",
+						"ttl":         "300",
+					}),
+					testAccDNSZoneRecordCount(testDomain(), "a-"+nameSuffix, 1),
+					testAccDNSZoneRecordCount(testDomain(), "txt-"+nameSuffix, 1),
+					resource.TestMatchResourceAttr(
+						"data.vercel_dns_zone_file.test",
+						"content",
+						regexp.MustCompile(fmt.Sprintf(`(?m)^txt-%s\t\d+\tIN\tTXT\t"terraform testing"$`, nameSuffix)),
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccDNSZoneFileConfig(testDomain, nameSuffix string) string {
+	return fmt.Sprintf(`
+resource "vercel_dns_zone" "test" {
+  domain    = "%[1]s"
+  zone_file = <<-EOT
+    $TTL 300
+    a-%[2]s            A     127.0.0.1
+    mx-%[2]s           MX    10 mail
+    srv-%[2]s          SRV   27 120 5000 example.com.
+    txt-%[2]s          TXT   "terraform testing"
+  EOT
+}
+
+data "vercel_dns_zone_file" "test" {
+  domain = vercel_dns_zone.test.domain
+}
+`, testDomain, nameSuffix)
+}
@@ -0,0 +1,363 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+var (
+	_ resource.Resource                = &domainResource{}
+	_ resource.ResourceWithConfigure   = &domainResource{}
+	_ resource.ResourceWithImportState = &domainResource{}
+	_ resource.ResourceWithModifyPlan  = &domainResource{}
+)
+
+func newDomainResource() resource.Resource {
+	return &domainResource{}
+}
+
+type domainResource struct {
+	client *client.Client
+}
+
+func (r *domainResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain"
+}
+
+func (r *domainResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema returns the schema information for a domain resource.
+func (r *domainResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides a Domain resource.
+
+A Domain must be added to an account before it can be used by a project, or before DNS records can be created for it.
+Domains that are registered elsewhere are added as external domains, and are verified either by pointing the
+domain's nameservers at Vercel, or by pointing the domain at Vercel with a CNAME record.
+
+For more detailed information, please see the [Vercel documentation](https://vercel.com/docs/projects/domains/add-a-domain).
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"team_id": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "The ID of the team the domain should exist under. Required when configuring a team resource if a default team has not been set in the provider. Changing this moves the domain to the new team.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Required:      true,
+				Description:   "The name of the domain, such as `example.com`.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"method": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("nameservers"),
+				Description: "How the domain is pointed at Vercel. Either `nameservers`, where the domain's DNS is managed by Vercel, or `cname`, where the domain's DNS is managed elsewhere. Defaults to `nameservers`.",
+				Validators: []validator.String{
+					stringOneOf("nameservers", "cname"),
+				},
+			},
+			"service_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "How Vercel currently serves the domain's DNS. This is `zeit.world` while the domain uses Vercel's nameservers, and `external` otherwise, so may differ from `method` until the domain's nameservers have been changed.",
+			},
+			"verified": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the domain has been verified.",
+			},
+			"nameservers": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The nameservers the domain currently uses.",
+			},
+			"intended_nameservers": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The nameservers the domain should use in order to be verified with the `nameservers` method.",
+			},
+			"verification_record": schema.StringAttribute{
+				Computed:    true,
+				Description: "The value of a TXT record that can be created for `_vercel.<name>` to verify ownership of the domain, if it is in use by another account.",
+			},
+		},
+	}
+}
+
+// Create will add a domain to an account within Vercel.
+// This is called automatically by the provider when a new resource should be created.
+func (r *domainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan Domain
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := r.client.CreateDomain(ctx, plan.TeamID.ValueString(), client.CreateDomainRequest{
+		Name:   plan.Name.ValueString(),
+		Method: "add",
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating domain",
+			"Could not create domain, unexpected error: "+describeError(err),
+		)
+		return
+	}
+
+	if !plan.zone() {
+		out, err = r.updateZone(ctx, plan)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating domain",
+				fmt.Sprintf("Could not set the method of domain %s, unexpected error: %s", plan.Name.ValueString(), describeError(err)),
+			)
+			return
+		}
+	}
+
+	result := convertResponseToDomain(out, plan.Method)
+	tflog.Trace(ctx, "created domain", map[string]interface{}{
+		"team_id": result.TeamID.ValueString(),
+		"domain":  result.Name.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// updateZone sets whether Vercel's nameservers are intended to be used for a domain, returning the updated domain.
+func (r *domainResource) updateZone(ctx context.Context, plan Domain) (client.DomainResponse, error) {
+	zone := plan.zone()
+	err := r.client.UpdateDomain(ctx, plan.Name.ValueString(), plan.TeamID.ValueString(), client.UpdateDomainRequest{
+		Op:   "update",
+		Zone: &zone,
+	})
+	if err != nil {
+		return client.DomainResponse{}, err
+	}
+	return r.client.GetDomain(ctx, plan.Name.ValueString(), plan.TeamID.ValueString())
+}
+
+// Read will read a domain from the vercel API and provide terraform with information about it.
+// It is called by the provider whenever values should be read to update state.
+func (r *domainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Domain
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := r.client.GetDomain(ctx, state.Name.ValueString(), state.TeamID.ValueString())
+	if client.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading domain",
+			fmt.Sprintf("Could not read domain %s %s, unexpected error: %s",
+				state.TeamID.ValueString(),
+				state.Name.ValueString(),
+				describeError(err),
+			),
+		)
+		return
+	}
+
+	result := convertResponseToDomain(out, state.Method)
+	tflog.Trace(ctx, "read domain", map[string]interface{}{
+		"team_id": result.TeamID.ValueString(),
+		"domain":  result.Name.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// ModifyPlan rejects moving an existing domain to a team that is not yet known, as the move could
+// otherwise not be shown in the plan.
+func (r *domainResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		// The domain is being created or destroyed.
+		return
+	}
+	var teamID types.String
+	diags := req.Plan.GetAttribute(ctx, path.Root("team_id"), &teamID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if teamID.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("team_id"),
+			"Domain Invalid",
+			"The team_id of an existing domain must be known when planning, so that moving the domain to another team can be planned. Create the team in a separate apply first.",
+		)
+	}
+}
+
+// Update will move a domain between teams, and update how it is pointed at Vercel, via the vercel API.
+func (r *domainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan Domain
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state Domain
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.TeamID.IsUnknown() && plan.TeamID.ValueString() != state.TeamID.ValueString() {
+		err := r.client.UpdateDomain(ctx, state.Name.ValueString(), state.TeamID.ValueString(), client.UpdateDomainRequest{
+			Op:          "move-out",
+			Destination: plan.TeamID.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating domain",
+				fmt.Sprintf("Could not move domain %s to team %s, unexpected error: %s",
+					state.Name.ValueString(),
+					plan.TeamID.ValueString(),
+					describeError(err),
+				),
+			)
+			return
+		}
+	}
+
+	out, err := r.updateZone(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating domain",
+			fmt.Sprintf("Could not update domain %s, unexpected error: %s", state.Name.ValueString(), describeError(err)),
+		)
+		return
+	}
+
+	result := convertResponseToDomain(out, plan.Method)
+	tflog.Trace(ctx, "updated domain", map[string]interface{}{
+		"team_id": result.TeamID.ValueString(),
+		"domain":  result.Name.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes a domain, along with all of its DNS records, from an account.
+func (r *domainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Domain
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDomain(ctx, state.Name.ValueString(), state.TeamID.ValueString())
+	if client.NotFound(err) {
+		// The domain is already gone - do nothing.
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting domain",
+			fmt.Sprintf("Could not delete domain %s %s, unexpected error: %s",
+				state.TeamID.ValueString(),
+				state.Name.ValueString(),
+				describeError(err),
+			),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted domain", map[string]interface{}{
+		"team_id": state.TeamID.ValueString(),
+		"domain":  state.Name.ValueString(),
+	})
+}
+
+// ImportState takes an identifier and reads all the domain information from the Vercel API.
+func (r *domainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	teamID, name, ok := splitID(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error importing domain",
+			fmt.Sprintf("Invalid id '%s' specified. should be in format \"team_id/domain\" or \"domain\"", req.ID),
+		)
+		return
+	}
+
+	out, err := r.client.GetDomain(ctx, name, teamID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading domain",
+			fmt.Sprintf("Could not get domain %s %s, unexpected error: %s",
+				teamID,
+				name,
+				describeError(err),
+			),
+		)
+		return
+	}
+
+	result := convertResponseToDomain(out, types.StringNull())
+	tflog.Trace(ctx, "imported domain", map[string]interface{}{
+		"team_id": result.TeamID.ValueString(),
+		"domain":  result.Name.ValueString(),
+	})
+
+	diags := resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,51 @@
+package vercel
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+// Domain reflects the state terraform stores internally for a domain.
+type Domain struct {
+	ID                  types.String `tfsdk:"id"`
+	TeamID              types.String `tfsdk:"team_id"`
+	Name                types.String `tfsdk:"name"`
+	Method              types.String `tfsdk:"method"`
+	ServiceType         types.String `tfsdk:"service_type"`
+	Verified            types.Bool   `tfsdk:"verified"`
+	Nameservers         types.List   `tfsdk:"nameservers"`
+	IntendedNameservers types.List   `tfsdk:"intended_nameservers"`
+	VerificationRecord  types.String `tfsdk:"verification_record"`
+}
+
+// zone returns whether Vercel's nameservers are intended to be used for the domain.
+func (d *Domain) zone() bool {
+	return d.Method.ValueString() != "cname"
+}
+
+// convertResponseToDomain converts a domain from the vercel API into the format terraform uses. The
+// service type Vercel reports reflects the current state of the domain's nameservers rather than
+// how it was configured, so the planned or prior method is kept, and the service type is only used
+// to work out the method of an imported domain.
+func convertResponseToDomain(response client.DomainResponse, method types.String) Domain {
+	if method.IsNull() || method.IsUnknown() {
+		// Domains that use Vercel's nameservers are managed by Vercel's DNS, while domains that
+		// point at Vercel with a CNAME record use external DNS.
+		method = types.StringValue("nameservers")
+		if response.ServiceType == "external" {
+			method = types.StringValue("cname")
+		}
+	}
+
+	return Domain{
+		ID:                  types.StringValue(response.ID),
+		TeamID:              toTeamID(response.TeamID),
+		Name:                types.StringValue(response.Name),
+		Method:              method,
+		ServiceType:         types.StringValue(response.ServiceType),
+		Verified:            types.BoolValue(response.Verified),
+		Nameservers:         toStringList(response.Nameservers),
+		IntendedNameservers: toStringList(response.IntendedNameservers),
+		VerificationRecord:  fromOptionalString(response.VerificationRecord),
+	}
+}
@@ -0,0 +1,110 @@
+package vercel_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+func testAccDomainExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		_, err := testClient().GetDomain(context.TODO(), rs.Primary.Attributes["name"], rs.Primary.Attributes["team_id"])
+		return err
+	}
+}
+
+func testAccDomainDestroy(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, teamID := range []string{"", testTeam()} {
+			_, err := testClient().GetDomain(context.TODO(), name, teamID)
+			if err == nil {
+				return fmt.Errorf("expected domain %s to have been deleted", name)
+			}
+			if !client.NotFound(err) {
+				return fmt.Errorf("Unexpected error checking for deleted domain: %s", err)
+			}
+		}
+		return nil
+	}
+}
+
+func TestAcc_Domain(t *testing.T) {
+	suffix := acctest.RandString(16)
+	name := fmt.Sprintf("test-acc-%s.com", suffix)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccDomainDestroy(name),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainConfig(suffix, teamIDConfig(), "nameservers"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccDomainExists("vercel_domain.test"),
+					resource.TestCheckResourceAttr("vercel_domain.test", "name", name),
+					resource.TestCheckResourceAttr("vercel_domain.test", "method", "nameservers"),
+					resource.TestCheckResourceAttr("vercel_domain.test", "verified", "false"),
+					resource.TestCheckResourceAttrSet("vercel_domain.test", "intended_nameservers.#"),
+				),
+			},
+			{
+				ResourceName:      "vercel_domain.test",
+				ImportState:       true,
+				ImportStateIdFunc: getDomainImportID("vercel_domain.test"),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDomainConfig(suffix, teamIDConfig(), "cname"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccDomainExists("vercel_domain.test"),
+					resource.TestCheckResourceAttr("vercel_domain.test", "method", "cname"),
+					resource.TestCheckResourceAttr("vercel_domain.test", "service_type", "external"),
+				),
+			},
+			{
+				Config: testAccDomainConfig(suffix, "team_id = vercel_team.test.id", "cname"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccDomainExists("vercel_domain.test"),
+					resource.TestCheckResourceAttrPair("vercel_domain.test", "team_id", "vercel_team.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func getDomainImportID(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.Attributes["team_id"] == "" {
+			return rs.Primary.Attributes["name"], nil
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["team_id"], rs.Primary.Attributes["name"]), nil
+	}
+}
+
+func testAccDomainConfig(suffix, teamID, method string) string {
+	return fmt.Sprintf(`
+resource "vercel_team" "test" {
+  slug = "test-acc-domain-%[1]s"
+}
+
+resource "vercel_domain" "test" {
+  name   = "test-acc-%[1]s.com"
+  method = "%[3]s"
+  %[2]s
+}
+`, suffix, teamID, method)
+}
@@ -4,12 +4,17 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -18,8 +23,9 @@ import (
 )
 
 var (
-	_ resource.Resource              = &projectResource{}
-	_ resource.ResourceWithConfigure = &projectResource{}
+	_ resource.Resource                   = &projectResource{}
+	_ resource.ResourceWithConfigure      = &projectResource{}
+	_ resource.ResourceWithValidateConfig = &projectResource{}
 )
 
 func newProjectResource() resource.Resource {
@@ -103,20 +109,85 @@ At this time you cannot use a Vercel Project resource with in-line ` + "`environ
 					validateServerlessFunctionRegion(),
 				},
 			},
+			"node_version": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The version of Node.js used to build the project and run its Serverless Functions. Must be one of `22.x`, `20.x` or `18.x`.",
+				Validators: []validator.String{
+					stringOneOf("22.x", "20.x", "18.x"),
+				},
+			},
+			"function_default_memory_type": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The default memory and CPU of the project's Serverless Functions. Must be one of `standard_legacy`, `standard` or `performance`.",
+				Validators: []validator.String{
+					stringOneOf("standard_legacy", "standard", "performance"),
+				},
+			},
+			"function_default_timeout": schema.Int64Attribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				Description:   "The default maximum duration, in seconds, that the project's Serverless Functions can run for. Must be between 1 and 900.",
+				Validators: []validator.Int64{
+					int64GreaterThan(1),
+					int64LessThan(900),
+				},
+			},
+			"skew_protection_max_age": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Enables Skew Protection, which keeps previous deployments available to clients that loaded them, for the given number of seconds. Must be at most 604800 (7 days). Skew Protection is disabled if this is not set.",
+				Validators: []validator.Int64{
+					int64GreaterThan(1),
+					int64LessThan(604800),
+				},
+			},
+			"build_machine_type": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The type of machine used to build the project. Must be one of `standard`, `enhanced` or `turbo`.",
+				Validators: []validator.String{
+					stringOneOf("standard", "enhanced", "turbo"),
+				},
+			},
+			"auto_expose_system_envs": schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+				Description:   "Whether System Environment Variables, such as `VERCEL_URL`, are automatically exposed to the project's builds and Serverless Functions.",
+			},
+			"source_files_outside_root_directory": schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+				Description:   "Whether files outside of the `root_directory` are included in builds. This is required for monorepos where the project depends on other packages in the workspace.",
+			},
 			"environment": schema.SetNestedAttribute{
 				Description: "A set of Environment Variables that should be configured for the project.",
 				Optional:    true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"target": schema.SetAttribute{
-							Description: "The environments that the Environment Variable should be present on. Valid targets are either `production`, `preview`, or `development`.",
+							Description: "The environments that the Environment Variable should be present on. Valid targets are either `production`, `preview`, or `development`. This may be empty if `custom_environment_ids` is set.",
 							ElementType: types.StringType,
 							Validators: []validator.Set{
 								stringSetItemsIn("production", "preview", "development"),
-								stringSetMinCount(1),
 							},
 							Required: true,
 						},
+						"custom_environment_ids": schema.SetAttribute{
+							Description: "The IDs of the `vercel_custom_environment`s that the Environment Variable should be present on. Custom Environments must be referenced by ID, not by slug.",
+							ElementType: types.StringType,
+							Optional:    true,
+							Validators: []validator.Set{
+								stringSetMinCount(1),
+								stringSetItemsRegex(customEnvironmentIDRegex, "Custom Environments must be referenced by their ID, which starts with `env_`"),
+							},
+						},
 						"git_branch": schema.StringAttribute{
 							Description: "The git branch of the Environment Variable.",
 							Optional:    true,
@@ -130,6 +201,22 @@ At this time you cannot use a Vercel Project resource with in-line ` + "`environ
 							Required:    true,
 							Sensitive:   true,
 						},
+						"type": schema.StringAttribute{
+							Description: "The type of the Environment Variable. Must be one of `plain`, `encrypted` or `sensitive`. The value of a `sensitive` Environment Variable cannot be read back from Vercel, so changes made to it outside of Terraform will not be detected. Defaults to `encrypted`.",
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString("encrypted"),
+							Validators: []validator.String{
+								stringOneOf("plain", "encrypted", "sensitive"),
+							},
+						},
+						"comment": schema.StringAttribute{
+							Description: "A comment explaining what the Environment Variable is for.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringLengthBetween(1, 500),
+							},
+						},
 						"id": schema.StringAttribute{
 							Description: "The ID of the Environment Variable.",
 							Computed:    true,
@@ -232,6 +319,31 @@ At this time you cannot use a Vercel Project resource with in-line ` + "`environ
 	}
 }
 
+// ValidateConfig validates the Resource configuration.
+func (r *projectResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var environmentSet types.Set
+	diags := req.Config.GetAttribute(ctx, path.Root("environment"), &environmentSet)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || environmentSet.IsNull() || environmentSet.IsUnknown() {
+		return
+	}
+
+	var environment []EnvironmentItem
+	diags = environmentSet.ElementsAs(ctx, &environment, true)
+	if diags.HasError() {
+		// The environment variables are not yet known, so cannot be validated.
+		return
+	}
+	for _, e := range environment {
+		if len(e.Target) == 0 && len(e.CustomEnvironmentIDs) == 0 {
+			resp.Diagnostics.AddError(
+				"Project Invalid",
+				fmt.Sprintf("The Environment Variable %s must have a `target` or `custom_environment_ids` specified", e.Key.ValueString()),
+			)
+		}
+	}
+}
+
 // Create will create a project within Vercel by calling the Vercel API.
 // This is called automatically by the provider when a new resource should be created.
 func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -246,7 +358,7 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error parsing project environment variables",
-			"Could not read environment variables, unexpected error: "+err.Error(),
+			"Could not read environment variables, unexpected error: "+describeError(err),
 		)
 		return
 	}
@@ -255,12 +367,12 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating project",
-			"Could not create project, unexpected error: "+err.Error(),
+			"Could not create project, unexpected error: "+describeError(err),
 		)
 		return
 	}
 
-	result := convertResponseToProject(out, plan)
+	result := convertResponseToProject(ctx, out, plan)
 	tflog.Trace(ctx, "created project", map[string]interface{}{
 		"team_id":    result.TeamID.ValueString(),
 		"project_id": result.ID.ValueString(),
@@ -271,17 +383,17 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	if plan.PasswordProtection != nil || plan.VercelAuthentication != nil {
+	if plan.requiresUpdateAfterCreation() {
 		out, err = r.client.UpdateProject(ctx, result.ID.ValueString(), plan.TeamID.ValueString(), plan.toUpdateProjectRequest(plan.Name.ValueString()), !plan.Environment.IsNull())
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error updating project as part of creating project",
-				"Could not update project, unexpected error: "+err.Error(),
+				"Could not update project, unexpected error: "+describeError(err),
 			)
 			return
 		}
 
-		result = convertResponseToProject(out, plan)
+		result = convertResponseToProject(ctx, out, plan)
 		tflog.Trace(ctx, "updated newly created project", map[string]interface{}{
 			"team_id":    result.TeamID.ValueString(),
 			"project_id": result.ID.ValueString(),
@@ -302,7 +414,7 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error adding protection bypass for automation",
-				"Failed to create project, an error occurred adding Protection Bypass For Automation: "+err.Error(),
+				"Failed to create project, an error occurred adding Protection Bypass For Automation: "+describeError(err),
 			)
 			return
 		}
@@ -327,12 +439,12 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating project",
-			"Failed to create project, an error occurred setting the production branch: "+err.Error(),
+			"Failed to create project, an error occurred setting the production branch: "+describeError(err),
 		)
 		return
 	}
 
-	result = convertResponseToProject(out, plan)
+	result = convertResponseToProject(ctx, out, plan)
 	tflog.Trace(ctx, "updated project production branch", map[string]interface{}{
 		"team_id":    result.TeamID.ValueString(),
 		"project_id": result.ID.ValueString(),
@@ -366,13 +478,13 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
 			fmt.Sprintf("Could not read project %s %s, unexpected error: %s",
 				state.TeamID.ValueString(),
 				state.ID.ValueString(),
-				err,
+				describeError(err),
 			),
 		)
 		return
 	}
 
-	result := convertResponseToProject(out, state)
+	result := convertResponseToProject(ctx, out, state)
 	tflog.Trace(ctx, "read project", map[string]interface{}{
 		"team_id":    result.TeamID.ValueString(),
 		"project_id": result.ID.ValueString(),
@@ -385,35 +497,60 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 }
 
-// containsEnvVar is a helper function for working out whether a specific environment variable
-// is present within a slice. It ensures that all properties of the environment variable match.
-func containsEnvVar(env []EnvironmentItem, v EnvironmentItem) bool {
-	for _, e := range env {
-		if e.ID == v.ID {
-			// we can rely on this because we use a known ID from state.
-			// and new env vars have no ID
-			return true
-		}
-	}
-	return false
+// envVarIdentity returns a string that uniquely identifies an environment variable within a
+// project. Vercel does not allow two environment variables with the same key, targets and git
+// branch, so these are used to match the environment variables in the plan to those in state.
+func envVarIdentity(e EnvironmentItem) string {
+	target := toStrings(e.Target)
+	sort.Strings(target)
+	customEnvironmentIDs := toStrings(e.CustomEnvironmentIDs)
+	sort.Strings(customEnvironmentIDs)
+	return fmt.Sprintf(
+		"%s|%s|%s|%s",
+		e.Key.ValueString(),
+		strings.Join(target, ","),
+		strings.Join(customEnvironmentIDs, ","),
+		e.GitBranch.ValueString(),
+	)
 }
 
-// diffEnvVars is used to determine the set of environment variables that need to be updated,
-// and the set of environment variables that need to be removed.
-func diffEnvVars(oldVars, newVars []EnvironmentItem) (toCreate, toRemove []EnvironmentItem) {
-	toRemove = []EnvironmentItem{}
+// diffEnvVars is used to determine the set of environment variables that need to be created,
+// the set that need their value or comment updating in place, and the set that need to be removed.
+// Environment variables are matched by key, target and git branch rather than by ID, as new
+// environment variables in the plan do not yet have an ID.
+func diffEnvVars(oldVars, newVars []EnvironmentItem) (toCreate, toUpdate, toRemove []EnvironmentItem) {
 	toCreate = []EnvironmentItem{}
+	toUpdate = []EnvironmentItem{}
+	toRemove = []EnvironmentItem{}
+	existing := map[string]EnvironmentItem{}
 	for _, e := range oldVars {
-		if !containsEnvVar(newVars, e) {
-			toRemove = append(toRemove, e)
-		}
+		existing[envVarIdentity(e)] = e
 	}
 	for _, e := range newVars {
-		if !containsEnvVar(oldVars, e) {
+		old, ok := existing[envVarIdentity(e)]
+		if !ok {
 			toCreate = append(toCreate, e)
+			continue
+		}
+		delete(existing, envVarIdentity(e))
+		if old.Type != e.Type {
+			// The type of an environment variable cannot always be changed, e.g. a sensitive
+			// environment variable can never be made readable again, so it is recreated.
+			toRemove = append(toRemove, old)
+			toCreate = append(toCreate, e)
+			continue
+		}
+		if old.Value != e.Value || old.Comment != e.Comment {
+			e.ID = old.ID
+			toUpdate = append(toUpdate, e)
 		}
 	}
-	return toCreate, toRemove
+	for _, e := range oldVars {
+		if _, ok := existing[envVarIdentity(e)]; ok {
+			toRemove = append(toRemove, e)
+		}
+	}
+	return toCreate, toUpdate, toRemove
 }
 
 // Update will update a project and it's associated environment variables via the vercel API.
@@ -439,7 +576,7 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error parsing project environment variables",
-			"Could not read environment variables, unexpected error: "+err.Error(),
+			"Could not read environment variables, unexpected error: "+describeError(err),
 		)
 		return
 	}
@@ -447,7 +584,7 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error parsing project environment variables from state",
-			"Could not read environment variables, unexpected error: "+err.Error(),
+			"Could not read environment variables, unexpected error: "+describeError(err),
 		)
 		return
 	}
@@ -457,7 +594,7 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 		"state_envs": stateEnvs,
 	})
 
-	toCreate, toRemove := diffEnvVars(stateEnvs, planEnvs)
+	toCreate, toUpdate, toRemove := diffEnvVars(stateEnvs, planEnvs)
 	for _, v := range toRemove {
 		err := r.client.DeleteEnvironmentVariable(ctx, state.ID.ValueString(), state.TeamID.ValueString(), v.ID.ValueString())
 		if err != nil {
@@ -467,7 +604,7 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 					"Could not remove environment variable %s (%s), unexpected error: %s",
 					v.Key.ValueString(),
 					v.ID.ValueString(),
-					err,
+					describeError(err),
 				),
 			)
 			return
@@ -479,6 +616,27 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 		})
 	}
 
+	for _, v := range toUpdate {
+		_, err := r.client.UpdateEnvironmentVariable(ctx, v.toUpdateEnvironmentVariableRequest(state.ID.ValueString(), state.TeamID.ValueString()))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating project",
+				fmt.Sprintf(
+					"Could not update environment variable %s (%s), unexpected error: %s",
+					v.Key.ValueString(),
+					v.ID.ValueString(),
+					describeError(err),
+				),
+			)
+			return
+		}
+		tflog.Trace(ctx, "updated environment variable", map[string]interface{}{
+			"team_id":        plan.TeamID.ValueString(),
+			"project_id":     plan.ID.ValueString(),
+			"environment_id": v.ID.ValueString(),
+		})
+	}
+
 	var items []client.EnvironmentVariableRequest
 	for _, v := range toCreate {
 		items = append(items, v.toEnvironmentVariableRequest())
@@ -499,9 +657,10 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 				fmt.Sprintf(
 					"Could not upsert environment variables for project %s, unexpected error: %s",
 					plan.ID.ValueString(),
-					err,
+					describeError(err),
 				),
 			)
+			return
 		}
 		tflog.Trace(ctx, "upserted environment variables", map[string]interface{}{
 			"team_id":    plan.TeamID.ValueString(),
@@ -523,7 +682,7 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 					"Could not update project %s %s, unexpected error setting Protection Bypass For Automation: %s",
 					state.TeamID.ValueString(),
 					state.ID.ValueString(),
-					err,
+					describeError(err),
 				),
 			)
 			return
@@ -538,7 +697,7 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 				"Could not update project %s %s, unexpected error: %s",
 				state.TeamID.ValueString(),
 				state.ID.ValueString(),
-				err,
+				describeError(err),
 			),
 		)
 		return
@@ -557,14 +716,14 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 					"Could not update project %s %s, unexpected error: %s",
 					state.TeamID.ValueString(),
 					state.ID.ValueString(),
-					err,
+					describeError(err),
 				),
 			)
 			return
 		}
 	}
 
-	result := convertResponseToProject(out, plan)
+	result := convertResponseToProject(ctx, out, plan)
 	tflog.Trace(ctx, "updated project", map[string]interface{}{
 		"team_id":    result.TeamID.ValueString(),
 		"project_id": result.ID.ValueString(),
@@ -598,7 +757,7 @@ func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest
 				"Could not delete project %s %s, unexpected error: %s",
 				state.TeamID.ValueString(),
 				state.ID.ValueString(),
-				err,
+				describeError(err),
 			),
 		)
 		return
@@ -623,36 +782,60 @@ func splitID(id string) (teamID, _id string, ok bool) {
 	return "", id, true
 }
 
+// importEnvironmentSuffix can be added to the ID of an imported project to also import its
+// environment variables.
+const importEnvironmentSuffix = "#environment"
+
 // ImportState takes an identifier and reads all the project information from the Vercel API.
-// Note that environment variables are also read. The results are then stored in terraform state.
+// Environment variables are only read if the identifier ends with importEnvironmentSuffix, as
+// otherwise they would be removed unless they were also added to the configuration.
+// The results are then stored in terraform state.
 func (r *projectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	teamID, projectID, ok := splitID(req.ID)
+	withEnvironment := strings.HasSuffix(req.ID, importEnvironmentSuffix)
+	teamID, projectID, ok := splitID(strings.TrimSuffix(req.ID, importEnvironmentSuffix))
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Error importing project",
-			fmt.Sprintf("Invalid id '%s' specified. should be in format \"team_id/project_id\" or \"project_id\"", req.ID),
+			fmt.Sprintf("Invalid id '%s' specified. should be in format \"team_id/project_id\" or \"project_id\", optionally followed by \"%s\"", req.ID, importEnvironmentSuffix),
 		)
+		return
 	}
 
-	out, err := r.client.GetProject(ctx, projectID, teamID, false)
+	out, err := r.client.GetProject(ctx, projectID, teamID, withEnvironment)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading project",
 			fmt.Sprintf("Could not get project %s %s, unexpected error: %s",
 				teamID,
 				projectID,
-				err,
+				describeError(err),
 			),
 		)
 		return
 	}
 
-	result := convertResponseToProject(out, nullProject)
+	result := convertResponseToProject(ctx, out, nullProject)
 	tflog.Trace(ctx, "imported project", map[string]interface{}{
 		"team_id":    result.TeamID.ValueString(),
 		"project_id": result.ID.ValueString(),
 	})
 
+	var sensitive []string
+	for _, e := range out.EnvironmentVariables {
+		if e.Type == "sensitive" {
+			sensitive = append(sensitive, e.Key)
+		}
+	}
+	if len(sensitive) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Sensitive environment variables imported without values",
+			fmt.Sprintf(
+				"The values of sensitive environment variables cannot be read from Vercel, so they have been imported with an empty value. Set the values of %s in the configuration to manage them with Terraform.",
+				strings.Join(sensitive, ", "),
+			),
+		)
+	}
+
 	diags := resp.State.Set(ctx, result)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
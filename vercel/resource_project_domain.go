@@ -2,14 +2,20 @@ package vercel
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/vercel/terraform-provider-vercel/client"
 )
@@ -50,14 +56,16 @@ func (r *projectDomainResource) Configure(ctx context.Context, req resource.Conf
 }
 
 // Schema returns the schema information for a deployment resource.
-func (r *projectDomainResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *projectDomainResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: `
 Provides a Project Domain resource.
 
 A Project Domain is used to associate a domain name with a ` + "`vercel_project`." + `
 
-By default, Project Domains will be automatically applied to any ` + "`production` deployments.",
+By default, Project Domains will be automatically applied to any ` + "`production` deployments." + `
+
+If the domain is already in use by another Vercel account, it must be verified before it can be used. The records required to verify the domain are exposed via the ` + "`verification`" + ` attribute. Setting ` + "`wait_for_verification`" + ` will cause Terraform to wait until the domain is verified, up to a default of 10 minutes. This can be changed with a ` + "`timeouts`" + ` block.`,
 		Attributes: map[string]schema.Attribute{
 			"project_id": schema.StringAttribute{
 				Description:   "The project ID to add the deployment to.",
@@ -94,10 +102,98 @@ By default, Project Domains will be automatically applied to any ` + "`productio
 				Description: "Git branch to link to the project domain. Deployments from this git branch will be assigned the domain name.",
 				Optional:    true,
 			},
+			"custom_environment_id": schema.StringAttribute{
+				Description: "The ID of a `vercel_custom_environment` to link to the project domain. Deployments to the Custom Environment will be assigned the domain name.",
+				Optional:    true,
+			},
+			"verified": schema.BoolAttribute{
+				Description: "Whether the domain has been verified for use with the project.",
+				Computed:    true,
+			},
+			"verification": schema.ListNestedAttribute{
+				Description: "The DNS records that must be created in order to verify the domain. This is empty once the domain is verified.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description: "The type of DNS record to create.",
+							Computed:    true,
+						},
+						"domain": schema.StringAttribute{
+							Description: "The name of the DNS record to create.",
+							Computed:    true,
+						},
+						"value": schema.StringAttribute{
+							Description: "The value of the DNS record to create.",
+							Computed:    true,
+						},
+						"reason": schema.StringAttribute{
+							Description: "Why the domain needs to be verified.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"wait_for_verification": schema.BoolAttribute{
+				Description: "Whether Terraform should wait for the domain to be verified after it is added to the project. Defaults to `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
 		},
 	}
 }
 
+// defaultDomainVerificationTimeout is how long to wait for a project domain to be verified if no
+// timeout has been configured.
+const defaultDomainVerificationTimeout = 10 * time.Minute
+
+// waitForVerification waits for a project domain to be verified, if it has not been verified
+// already and the plan requests it. If the domain is not verified in time, the last known state of
+// the domain is returned alongside an error describing the records that need creating.
+func (r *projectDomainResource) waitForVerification(ctx context.Context, plan ProjectDomain, out client.ProjectDomainResponse, timeout time.Duration) (client.ProjectDomainResponse, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if out.Verified || !plan.WaitForVerification.ValueBool() {
+		return out, diags
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	verified, err := r.client.WaitForProjectDomainVerification(ctx, out.ProjectID, out.Name, plan.TeamID.ValueString())
+	if err == nil {
+		return verified, diags
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		diags.AddError(
+			"Error verifying project domain",
+			fmt.Sprintf("Could not verify domain %s, unexpected error: %s", out.Name, describeError(err)),
+		)
+		return out, diags
+	}
+
+	var records []string
+	for _, v := range out.Verification {
+		records = append(records, fmt.Sprintf("%s record for %s with the value %q", v.Type, v.Domain, v.Value))
+	}
+	diags.AddError(
+		"Error verifying project domain",
+		fmt.Sprintf(
+			"The domain %s was not verified within %s. To verify the domain, create the following DNS records: %s. The timeout can be increased with a `timeouts` block: %s",
+			out.Name,
+			timeout,
+			strings.Join(records, ", "),
+			err,
+		),
+	)
+	return out, diags
+}
+
 // Create will create a project domain within Vercel.
 // This is called automatically by the provider when a new resource should be created.
 func (r *projectDomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -117,6 +213,12 @@ func (r *projectDomainResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultDomainVerificationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	out, err := r.client.CreateProjectDomain(ctx, plan.ProjectID.ValueString(), plan.TeamID.ValueString(), plan.toCreateRequest())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -125,13 +227,17 @@ func (r *projectDomainResource) Create(ctx context.Context, req resource.CreateR
 				"Could not add domain %s to project %s, unexpected error: %s",
 				plan.Domain.ValueString(),
 				plan.ProjectID.ValueString(),
-				err,
+				describeError(err),
 			),
 		)
 		return
 	}
 
-	result := convertResponseToProjectDomain(out)
+	// The domain has been added, so it is stored in state even if it fails verification.
+	out, verifyDiags := r.waitForVerification(ctx, plan, out, createTimeout)
+	resp.Diagnostics.Append(verifyDiags...)
+
+	result := convertResponseToProjectDomain(out, plan)
 	tflog.Trace(ctx, "added domain to project", map[string]interface{}{
 		"project_id": result.ProjectID.ValueString(),
 		"domain":     result.Domain.ValueString(),
@@ -140,9 +246,6 @@ func (r *projectDomainResource) Create(ctx context.Context, req resource.CreateR
 
 	diags = resp.State.Set(ctx, result)
 	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
 }
 
 // Read will read a project domain from the vercel API and provide terraform with information about it.
@@ -166,13 +269,13 @@ func (r *projectDomainResource) Read(ctx context.Context, req resource.ReadReque
 			fmt.Sprintf("Could not get domain %s for project %s, unexpected error: %s",
 				state.Domain.ValueString(),
 				state.ProjectID.ValueString(),
-				err,
+				describeError(err),
 			),
 		)
 		return
 	}
 
-	result := convertResponseToProjectDomain(out)
+	result := convertResponseToProjectDomain(out, state)
 	tflog.Trace(ctx, "read project domain", map[string]interface{}{
 		"project_id": result.ProjectID.ValueString(),
 		"domain":     result.Domain.ValueString(),
@@ -195,6 +298,12 @@ func (r *projectDomainResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultDomainVerificationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	out, err := r.client.UpdateProjectDomain(
 		ctx,
 		plan.ProjectID.ValueString(),
@@ -208,13 +317,16 @@ func (r *projectDomainResource) Update(ctx context.Context, req resource.UpdateR
 			fmt.Sprintf("Could not update domain %s for project %s, unexpected error: %s",
 				plan.Domain.ValueString(),
 				plan.ProjectID.ValueString(),
-				err,
+				describeError(err),
 			),
 		)
 		return
 	}
 
-	result := convertResponseToProjectDomain(out)
+	out, verifyDiags := r.waitForVerification(ctx, plan, out, updateTimeout)
+	resp.Diagnostics.Append(verifyDiags...)
+
+	result := convertResponseToProjectDomain(out, plan)
 	tflog.Trace(ctx, "update project domain", map[string]interface{}{
 		"project_id": result.ProjectID.ValueString(),
 		"domain":     result.Domain.ValueString(),
@@ -223,9 +335,6 @@ func (r *projectDomainResource) Update(ctx context.Context, req resource.UpdateR
 
 	diags = resp.State.Set(ctx, result)
 	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
 }
 
 // Delete will remove a project domain via the Vercel API.
@@ -250,7 +359,7 @@ func (r *projectDomainResource) Delete(ctx context.Context, req resource.DeleteR
 				"Could not delete domain %s for project %s, unexpected error: %s",
 				state.Domain.ValueString(),
 				state.ProjectID.ValueString(),
-				err,
+				describeError(err),
 			),
 		)
 		return
@@ -296,13 +405,16 @@ func (r *projectDomainResource) ImportState(ctx context.Context, req resource.Im
 			fmt.Sprintf("Could not get domain %s for project %s, unexpected error: %s",
 				domain,
 				projectID,
-				err,
+				describeError(err),
 			),
 		)
 		return
 	}
 
-	result := convertResponseToProjectDomain(out)
+	result := convertResponseToProjectDomain(out, ProjectDomain{
+		WaitForVerification: types.BoolValue(false),
+		Timeouts:            projectDomainTimeoutsNull,
+	})
 	tflog.Trace(ctx, "imported project domain", map[string]interface{}{
 		"project_id": result.ProjectID.ValueString(),
 		"domain":     result.Domain.ValueString(),
@@ -1,46 +1,97 @@
 package vercel
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/vercel/terraform-provider-vercel/client"
 )
 
 // ProjectDomain reflects the state terraform stores internally for a project domain.
 type ProjectDomain struct {
-	Domain             types.String `tfsdk:"domain"`
-	GitBranch          types.String `tfsdk:"git_branch"`
-	ID                 types.String `tfsdk:"id"`
-	ProjectID          types.String `tfsdk:"project_id"`
-	Redirect           types.String `tfsdk:"redirect"`
-	RedirectStatusCode types.Int64  `tfsdk:"redirect_status_code"`
-	TeamID             types.String `tfsdk:"team_id"`
+	CustomEnvironmentID types.String   `tfsdk:"custom_environment_id"`
+	Domain              types.String   `tfsdk:"domain"`
+	GitBranch           types.String   `tfsdk:"git_branch"`
+	ID                  types.String   `tfsdk:"id"`
+	ProjectID           types.String   `tfsdk:"project_id"`
+	Redirect            types.String   `tfsdk:"redirect"`
+	RedirectStatusCode  types.Int64    `tfsdk:"redirect_status_code"`
+	TeamID              types.String   `tfsdk:"team_id"`
+	Verified            types.Bool     `tfsdk:"verified"`
+	Verification        types.List     `tfsdk:"verification"`
+	WaitForVerification types.Bool     `tfsdk:"wait_for_verification"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
 }
 
-func convertResponseToProjectDomain(response client.ProjectDomainResponse) ProjectDomain {
+var domainVerificationElemType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"type":   types.StringType,
+		"domain": types.StringType,
+		"value":  types.StringType,
+		"reason": types.StringType,
+	},
+}
+
+// projectDomainTimeoutsNull is used for the timeouts block when there is no configuration to take
+// it from, such as when a project domain is imported.
+var projectDomainTimeoutsNull = timeouts.Value{
+	Object: types.ObjectNull(map[string]attr.Type{
+		"create": types.StringType,
+		"update": types.StringType,
+	}),
+}
+
+// convertResponseToProjectDomain converts the API response into terraform state. The
+// wait_for_verification and timeouts fields only exist within terraform, so they are taken from
+// the plan or prior state.
+func convertResponseToProjectDomain(response client.ProjectDomainResponse, plan ProjectDomain) ProjectDomain {
+	verification := []attr.Value{}
+	for _, v := range response.Verification {
+		verification = append(verification, types.ObjectValueMust(
+			domainVerificationElemType.AttrTypes,
+			map[string]attr.Value{
+				"type":   types.StringValue(v.Type),
+				"domain": types.StringValue(v.Domain),
+				"value":  types.StringValue(v.Value),
+				"reason": types.StringValue(v.Reason),
+			},
+		))
+	}
+	waitForVerification := plan.WaitForVerification
+	if waitForVerification.IsNull() || waitForVerification.IsUnknown() {
+		waitForVerification = types.BoolValue(false)
+	}
 	return ProjectDomain{
-		Domain:             types.StringValue(response.Name),
-		GitBranch:          fromStringPointer(response.GitBranch),
-		ID:                 types.StringValue(response.Name),
-		ProjectID:          types.StringValue(response.ProjectID),
-		Redirect:           fromStringPointer(response.Redirect),
-		RedirectStatusCode: fromInt64Pointer(response.RedirectStatusCode),
-		TeamID:             toTeamID(response.TeamID),
+		CustomEnvironmentID: fromStringPointer(response.CustomEnvironmentID),
+		Domain:              types.StringValue(response.Name),
+		GitBranch:           fromStringPointer(response.GitBranch),
+		ID:                  types.StringValue(response.Name),
+		ProjectID:           types.StringValue(response.ProjectID),
+		Redirect:            fromStringPointer(response.Redirect),
+		RedirectStatusCode:  fromInt64Pointer(response.RedirectStatusCode),
+		TeamID:              toTeamID(response.TeamID),
+		Verified:            types.BoolValue(response.Verified),
+		Verification:        types.ListValueMust(domainVerificationElemType, verification),
+		WaitForVerification: waitForVerification,
+		Timeouts:            plan.Timeouts,
 	}
 }
 
 func (p *ProjectDomain) toCreateRequest() client.CreateProjectDomainRequest {
 	return client.CreateProjectDomainRequest{
-		GitBranch:          p.GitBranch.ValueString(),
-		Name:               p.Domain.ValueString(),
-		Redirect:           p.Redirect.ValueString(),
-		RedirectStatusCode: p.RedirectStatusCode.ValueInt64(),
+		CustomEnvironmentID: p.CustomEnvironmentID.ValueString(),
+		GitBranch:           p.GitBranch.ValueString(),
+		Name:                p.Domain.ValueString(),
+		Redirect:            p.Redirect.ValueString(),
+		RedirectStatusCode:  p.RedirectStatusCode.ValueInt64(),
 	}
 }
 
 func (p *ProjectDomain) toUpdateRequest() client.UpdateProjectDomainRequest {
 	return client.UpdateProjectDomainRequest{
-		GitBranch:          toStrPointer(p.GitBranch),
-		Redirect:           toStrPointer(p.Redirect),
-		RedirectStatusCode: toInt64Pointer(p.RedirectStatusCode),
+		CustomEnvironmentID: toStrPointer(p.CustomEnvironmentID),
+		GitBranch:           toStrPointer(p.GitBranch),
+		Redirect:            toStrPointer(p.Redirect),
+		RedirectStatusCode:  toInt64Pointer(p.RedirectStatusCode),
 	}
 }
@@ -33,6 +33,9 @@ func TestAcc_ProjectDomain(t *testing.T) {
 					testAccProjectDomainExists("vercel_project.test", testTeam(), domain),
 					testTeamID,
 					resource.TestCheckResourceAttr("vercel_project_domain.test", "domain", domain),
+					resource.TestCheckResourceAttr("vercel_project_domain.test", "verified", "true"),
+					resource.TestCheckResourceAttr("vercel_project_domain.test", "verification.#", "0"),
+					resource.TestCheckResourceAttr("vercel_project_domain.test", "wait_for_verification", "false"),
 				),
 			},
 			// Update testing
@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -16,8 +18,9 @@ import (
 )
 
 var (
-	_ resource.Resource              = &projectEnvironmentVariableResource{}
-	_ resource.ResourceWithConfigure = &projectEnvironmentVariableResource{}
+	_ resource.Resource                   = &projectEnvironmentVariableResource{}
+	_ resource.ResourceWithConfigure      = &projectEnvironmentVariableResource{}
+	_ resource.ResourceWithValidateConfig = &projectEnvironmentVariableResource{}
 )
 
 func newProjectEnvironmentVariableResource() resource.Resource {
@@ -66,11 +69,19 @@ At this time you cannot use a Vercel Project resource with in-line ` + "`environ
 		Attributes: map[string]schema.Attribute{
 			"target": schema.SetAttribute{
 				Required:    true,
-				Description: "The environments that the Environment Variable should be present on. Valid targets are either `production`, `preview`, or `development`.",
+				Description: "The environments that the Environment Variable should be present on. Valid targets are either `production`, `preview`, or `development`. This may be empty if `custom_environment_ids` is set.",
 				ElementType: types.StringType,
 				Validators: []validator.Set{
 					stringSetItemsIn("production", "preview", "development"),
+				},
+			},
+			"custom_environment_ids": schema.SetAttribute{
+				Optional:    true,
+				Description: "The IDs of the `vercel_custom_environment`s that the Environment Variable should be present on. Custom Environments must be referenced by ID, not by slug.",
+				ElementType: types.StringType,
+				Validators: []validator.Set{
 					stringSetMinCount(1),
+					stringSetItemsRegex(customEnvironmentIDRegex, "Custom Environments must be referenced by their ID, which starts with `env_`"),
 				},
 			},
 			"key": schema.StringAttribute{
@@ -83,6 +94,23 @@ At this time you cannot use a Vercel Project resource with in-line ` + "`environ
 				Description: "The value of the Environment Variable.",
 				Sensitive:   true,
 			},
+			"type": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "The type of the Environment Variable. Must be one of `plain`, `encrypted` or `sensitive`. The value of a `sensitive` Environment Variable cannot be read back from Vercel, so changes made to it outside of Terraform will not be detected. Defaults to `encrypted`.",
+				Default:       stringdefault.StaticString("encrypted"),
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators: []validator.String{
+					stringOneOf("plain", "encrypted", "sensitive"),
+				},
+			},
+			"comment": schema.StringAttribute{
+				Optional:    true,
+				Description: "A comment explaining what the Environment Variable is for.",
+				Validators: []validator.String{
+					stringLengthBetween(1, 500),
+				},
+			},
 			"git_branch": schema.StringAttribute{
 				Optional:    true,
 				Description: "The git branch of the Environment Variable.",
@@ -107,6 +135,23 @@ At this time you cannot use a Vercel Project resource with in-line ` + "`environ
 	}
 }
 
+// ValidateConfig validates the Resource configuration.
+func (r *projectEnvironmentVariableResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var target, customEnvironmentIDs types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("target"), &target)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("custom_environment_ids"), &customEnvironmentIDs)...)
+	if resp.Diagnostics.HasError() || target.IsUnknown() || customEnvironmentIDs.IsUnknown() {
+		return
+	}
+
+	if len(target.Elements()) == 0 && len(customEnvironmentIDs.Elements()) == 0 {
+		resp.Diagnostics.AddError(
+			"Project Environment Variable Invalid",
+			"A Project Environment Variable must have a `target` or `custom_environment_ids` specified",
+		)
+	}
+}
+
 // Create will create a new project environment variable for a Vercel project.
 // This is called automatically by the provider when a new resource should be created.
 func (r *projectEnvironmentVariableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -130,12 +175,12 @@ func (r *projectEnvironmentVariableResource) Create(ctx context.Context, req res
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating project environment variable",
-			"Could not create project environment variable, unexpected error: "+err.Error(),
+			"Could not create project environment variable, unexpected error: "+describeError(err),
 		)
 		return
 	}
 
-	result := convertResponseToProjectEnvironmentVariable(response, plan.ProjectID)
+	result := convertResponseToProjectEnvironmentVariable(response, plan.ProjectID, plan.Value)
 
 	tflog.Trace(ctx, "created project environment variable", map[string]interface{}{
 		"id":         result.ID.ValueString(),
@@ -172,13 +217,13 @@ func (r *projectEnvironmentVariableResource) Read(ctx context.Context, req resou
 				state.ID.ValueString(),
 				state.ProjectID.ValueString(),
 				state.TeamID.ValueString(),
-				err,
+				describeError(err),
 			),
 		)
 		return
 	}
 
-	result := convertResponseToProjectEnvironmentVariable(out, state.ProjectID)
+	result := convertResponseToProjectEnvironmentVariable(out, state.ProjectID, state.Value)
 	tflog.Trace(ctx, "read project environment variable", map[string]interface{}{
 		"id":         result.ID.ValueString(),
 		"team_id":    result.TeamID.ValueString(),
@@ -205,12 +250,12 @@ func (r *projectEnvironmentVariableResource) Update(ctx context.Context, req res
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating project environment variable",
-			"Could not update project environment variable, unexpected error: "+err.Error(),
+			"Could not update project environment variable, unexpected error: "+describeError(err),
 		)
 		return
 	}
 
-	result := convertResponseToProjectEnvironmentVariable(response, plan.ProjectID)
+	result := convertResponseToProjectEnvironmentVariable(response, plan.ProjectID, plan.Value)
 
 	tflog.Trace(ctx, "updated project environment variable", map[string]interface{}{
 		"id":         result.ID.ValueString(),
@@ -244,7 +289,7 @@ func (r *projectEnvironmentVariableResource) Delete(ctx context.Context, req res
 			fmt.Sprintf(
 				"Could not delete project environment variable %s, unexpected error: %s",
 				state.ID.ValueString(),
-				err,
+				describeError(err),
 			),
 		)
 		return
@@ -290,13 +335,13 @@ func (r *projectEnvironmentVariableResource) ImportState(ctx context.Context, re
 				teamID,
 				projectID,
 				envID,
-				err,
+				describeError(err),
 			),
 		)
 		return
 	}
 
-	result := convertResponseToProjectEnvironmentVariable(out, types.StringValue(projectID))
+	result := convertResponseToProjectEnvironmentVariable(out, types.StringValue(projectID), types.StringNull())
 	tflog.Trace(ctx, "imported project environment variable", map[string]interface{}{
 		"team_id":    result.TeamID.ValueString(),
 		"project_id": result.ProjectID.ValueString(),
@@ -7,27 +7,28 @@ import (
 
 // ProjectEnvironmentVariable reflects the state terraform stores internally for a project environment variable.
 type ProjectEnvironmentVariable struct {
-	Target    []types.String `tfsdk:"target"`
-	GitBranch types.String   `tfsdk:"git_branch"`
-	Key       types.String   `tfsdk:"key"`
-	Value     types.String   `tfsdk:"value"`
-	TeamID    types.String   `tfsdk:"team_id"`
-	ProjectID types.String   `tfsdk:"project_id"`
-	ID        types.String   `tfsdk:"id"`
+	Target               []types.String `tfsdk:"target"`
+	CustomEnvironmentIDs []types.String `tfsdk:"custom_environment_ids"`
+	GitBranch            types.String   `tfsdk:"git_branch"`
+	Key                  types.String   `tfsdk:"key"`
+	Value                types.String   `tfsdk:"value"`
+	Type                 types.String   `tfsdk:"type"`
+	Comment              types.String   `tfsdk:"comment"`
+	TeamID               types.String   `tfsdk:"team_id"`
+	ProjectID            types.String   `tfsdk:"project_id"`
+	ID                   types.String   `tfsdk:"id"`
 }
 
 func (e *ProjectEnvironmentVariable) toCreateEnvironmentVariableRequest() client.CreateEnvironmentVariableRequest {
-	target := []string{}
-	for _, t := range e.Target {
-		target = append(target, t.ValueString())
-	}
 	return client.CreateEnvironmentVariableRequest{
 		EnvironmentVariable: client.EnvironmentVariableRequest{
-			Key:       e.Key.ValueString(),
-			Value:     e.Value.ValueString(),
-			Target:    target,
-			GitBranch: toStrPointer(e.GitBranch),
-			Type:      "encrypted",
+			Key:                  e.Key.ValueString(),
+			Value:                e.Value.ValueString(),
+			Target:               toStrings(e.Target),
+			CustomEnvironmentIDs: toStrings(e.CustomEnvironmentIDs),
+			GitBranch:            toStrPointer(e.GitBranch),
+			Type:                 e.Type.ValueString(),
+			Comment:              e.Comment.ValueString(),
 		},
 		ProjectID: e.ProjectID.ValueString(),
 		TeamID:    e.TeamID.ValueString(),
@@ -35,38 +36,43 @@ func (e *ProjectEnvironmentVariable) toCreateEnvironmentVariableRequest() client
 }
 
 func (e *ProjectEnvironmentVariable) toUpdateEnvironmentVariableRequest() client.UpdateEnvironmentVariableRequest {
-	target := []string{}
-	for _, t := range e.Target {
-		target = append(target, t.ValueString())
-	}
 	return client.UpdateEnvironmentVariableRequest{
-		Key:       e.Key.ValueString(),
-		Value:     e.Value.ValueString(),
-		Target:    target,
-		GitBranch: toStrPointer(e.GitBranch),
-		Type:      "encrypted",
-		ProjectID: e.ProjectID.ValueString(),
-		TeamID:    e.TeamID.ValueString(),
-		EnvID:     e.ID.ValueString(),
+		Key:                  e.Key.ValueString(),
+		Value:                e.Value.ValueString(),
+		Target:               toStrings(e.Target),
+		CustomEnvironmentIDs: toStrings(e.CustomEnvironmentIDs),
+		GitBranch:            toStrPointer(e.GitBranch),
+		Type:                 e.Type.ValueString(),
+		Comment:              e.Comment.ValueString(),
+		ProjectID:            e.ProjectID.ValueString(),
+		TeamID:               e.TeamID.ValueString(),
+		EnvID:                e.ID.ValueString(),
 	}
 }
 
 // convertResponseToProjectEnvironmentVariable is used to populate terraform state based on an API response.
 // Where possible, values from the API response are used to populate state. If not possible,
-// values from plan are used.
-func convertResponseToProjectEnvironmentVariable(response client.EnvironmentVariable, projectID types.String) ProjectEnvironmentVariable {
+// values from plan are used. The value of a sensitive environment variable can never be read
+// from the API, so the value passed in is used instead.
+func convertResponseToProjectEnvironmentVariable(response client.EnvironmentVariable, projectID types.String, value types.String) ProjectEnvironmentVariable {
 	target := []types.String{}
 	for _, t := range response.Target {
 		target = append(target, types.StringValue(t))
 	}
+	if response.Type != "sensitive" {
+		value = types.StringValue(response.Value)
+	}
 
 	return ProjectEnvironmentVariable{
-		Target:    target,
-		GitBranch: fromStringPointer(response.GitBranch),
-		Key:       types.StringValue(response.Key),
-		Value:     types.StringValue(response.Value),
-		TeamID:    toTeamID(response.TeamID),
-		ProjectID: projectID,
-		ID:        types.StringValue(response.ID),
+		Target:               target,
+		CustomEnvironmentIDs: fromStrings(response.CustomEnvironmentIDs),
+		GitBranch:            fromStringPointer(response.GitBranch),
+		Key:                  types.StringValue(response.Key),
+		Value:                value,
+		Type:                 types.StringValue(response.Type),
+		Comment:              fromOptionalString(response.Comment),
+		TeamID:               toTeamID(response.TeamID),
+		ProjectID:            projectID,
+		ID:                   types.StringValue(response.ID),
 	}
 }
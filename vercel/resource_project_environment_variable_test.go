@@ -72,6 +72,13 @@ func TestAcc_ProjectEnvironmentVariables(t *testing.T) {
 					resource.TestCheckResourceAttr("vercel_project_environment_variable.example_git_branch", "value", "bar-staging"),
 					resource.TestCheckTypeSetElemAttr("vercel_project_environment_variable.example_git_branch", "target.*", "preview"),
 					resource.TestCheckResourceAttr("vercel_project_environment_variable.example_git_branch", "git_branch", "production"),
+
+					testAccProjectEnvironmentVariableExists("vercel_project_environment_variable.example_sensitive", testTeam()),
+					resource.TestCheckResourceAttr("vercel_project_environment_variable.example_sensitive", "value", "bar-sensitive"),
+					resource.TestCheckResourceAttr("vercel_project_environment_variable.example_sensitive", "type", "sensitive"),
+					resource.TestCheckResourceAttr("vercel_project_environment_variable.example_sensitive", "comment", "a sensitive value"),
+					resource.TestCheckResourceAttr("vercel_project_environment_variable.example", "type", "encrypted"),
+					resource.TestCheckNoResourceAttr("vercel_project_environment_variable.example", "comment"),
 				),
 			},
 			{
@@ -88,6 +95,12 @@ func TestAcc_ProjectEnvironmentVariables(t *testing.T) {
 					resource.TestCheckResourceAttr("vercel_project_environment_variable.example_git_branch", "value", "bar-staging"),
 					resource.TestCheckTypeSetElemAttr("vercel_project_environment_variable.example_git_branch", "target.*", "preview"),
 					resource.TestCheckResourceAttr("vercel_project_environment_variable.example_git_branch", "git_branch", "test"),
+
+					testAccProjectEnvironmentVariableExists("vercel_project_environment_variable.example_sensitive", testTeam()),
+					resource.TestCheckResourceAttr("vercel_project_environment_variable.example_sensitive", "value", "bar-sensitive-new"),
+					resource.TestCheckResourceAttr("vercel_project_environment_variable.example_sensitive", "type", "sensitive"),
+					resource.TestCheckNoResourceAttr("vercel_project_environment_variable.example_sensitive", "comment"),
+					resource.TestCheckResourceAttr("vercel_project_environment_variable.example", "type", "plain"),
 				),
 			},
 			{
@@ -102,6 +115,14 @@ func TestAcc_ProjectEnvironmentVariables(t *testing.T) {
 				ImportStateVerify: true,
 				ImportStateIdFunc: getProjectEnvironmentVariableImportID("vercel_project_environment_variable.example_git_branch"),
 			},
+			{
+				ResourceName:      "vercel_project_environment_variable.example_sensitive",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getProjectEnvironmentVariableImportID("vercel_project_environment_variable.example_sensitive"),
+				// The value of a sensitive environment variable cannot be read back.
+				ImportStateVerifyIgnore: []string{"value"},
+			},
 			{
 				Config: testAccProjectEnvironmentVariablesConfigDeleted(nameSuffix),
 				Check: resource.ComposeAggregateTestCheckFunc(
@@ -158,6 +179,16 @@ resource "vercel_project_environment_variable" "example_git_branch" {
 	target     = ["preview"]
     git_branch = "production"
 }
+
+resource "vercel_project_environment_variable" "example_sensitive" {
+	project_id = vercel_project.example.id
+	%[3]s
+	key        = "baz"
+	value      = "bar-sensitive"
+	target     = ["production"]
+	type       = "sensitive"
+	comment    = "a sensitive value"
+}
 `, projectName, testGithubRepo(), teamIDConfig())
 }
 
@@ -179,6 +210,7 @@ resource "vercel_project_environment_variable" "example" {
     key        = "foo"
     value      = "bar-new"
     target     = ["production", "preview"]
+    type       = "plain"
 }
 
 resource "vercel_project_environment_variable" "example_git_branch" {
@@ -189,6 +221,15 @@ resource "vercel_project_environment_variable" "example_git_branch" {
     target     = ["preview"]
     git_branch = "test"
 }
+
+resource "vercel_project_environment_variable" "example_sensitive" {
+    project_id = vercel_project.example.id
+    %[3]s
+    key        = "baz"
+    value      = "bar-sensitive-new"
+    target     = ["production"]
+    type       = "sensitive"
+}
 `, projectName, testGithubRepo(), teamIDConfig())
 }
 
@@ -11,19 +11,26 @@ import (
 
 // Project reflects the state terraform stores internally for a project.
 type Project struct {
+	AutoExposeSystemEnvs                types.Bool            `tfsdk:"auto_expose_system_envs"`
 	BuildCommand                        types.String          `tfsdk:"build_command"`
+	BuildMachineType                    types.String          `tfsdk:"build_machine_type"`
 	DevCommand                          types.String          `tfsdk:"dev_command"`
 	Environment                         types.Set             `tfsdk:"environment"`
 	Framework                           types.String          `tfsdk:"framework"`
+	FunctionDefaultMemoryType           types.String          `tfsdk:"function_default_memory_type"`
+	FunctionDefaultTimeout              types.Int64           `tfsdk:"function_default_timeout"`
 	GitRepository                       *GitRepository        `tfsdk:"git_repository"`
 	ID                                  types.String          `tfsdk:"id"`
 	IgnoreCommand                       types.String          `tfsdk:"ignore_command"`
 	InstallCommand                      types.String          `tfsdk:"install_command"`
 	Name                                types.String          `tfsdk:"name"`
+	NodeVersion                         types.String          `tfsdk:"node_version"`
 	OutputDirectory                     types.String          `tfsdk:"output_directory"`
 	PublicSource                        types.Bool            `tfsdk:"public_source"`
 	RootDirectory                       types.String          `tfsdk:"root_directory"`
 	ServerlessFunctionRegion            types.String          `tfsdk:"serverless_function_region"`
+	SkewProtectionMaxAge                types.Int64           `tfsdk:"skew_protection_max_age"`
+	SourceFilesOutsideRootDirectory     types.Bool            `tfsdk:"source_files_outside_root_directory"`
 	TeamID                              types.String          `tfsdk:"team_id"`
 	VercelAuthentication                *VercelAuthentication `tfsdk:"vercel_authentication"`
 	PasswordProtection                  *PasswordProtection   `tfsdk:"password_protection"`
@@ -57,18 +64,15 @@ func (p *Project) environment(ctx context.Context) ([]EnvironmentItem, error) {
 func parseEnvironment(vars []EnvironmentItem) []client.EnvironmentVariable {
 	out := []client.EnvironmentVariable{}
 	for _, e := range vars {
-		target := []string{}
-		for _, t := range e.Target {
-			target = append(target, t.ValueString())
-		}
-
 		out = append(out, client.EnvironmentVariable{
-			Key:       e.Key.ValueString(),
-			Value:     e.Value.ValueString(),
-			Target:    target,
-			GitBranch: toStrPointer(e.GitBranch),
-			Type:      "encrypted",
-			ID:        e.ID.ValueString(),
+			Key:                  e.Key.ValueString(),
+			Value:                e.Value.ValueString(),
+			Target:               toStrings(e.Target),
+			CustomEnvironmentIDs: toStrings(e.CustomEnvironmentIDs),
+			GitBranch:            toStrPointer(e.GitBranch),
+			Type:                 e.Type.ValueString(),
+			Comment:              e.Comment.ValueString(),
+			ID:                   e.ID.ValueString(),
 		})
 	}
 	return out
@@ -97,42 +101,99 @@ func (p *Project) toUpdateProjectRequest(oldName string) client.UpdateProjectReq
 		n := p.Name.ValueString()
 		name = &n
 	}
+	// Skew protection is disabled by setting the max age to 0.
+	skewProtectionMaxAge := toPtr(int64(0))
+	if !p.SkewProtectionMaxAge.IsNull() && !p.SkewProtectionMaxAge.IsUnknown() {
+		skewProtectionMaxAge = toPtr(p.SkewProtectionMaxAge.ValueInt64())
+	}
 	return client.UpdateProjectRequest{
-		BuildCommand:                toStrPointer(p.BuildCommand),
-		CommandForIgnoringBuildStep: toStrPointer(p.IgnoreCommand),
-		DevCommand:                  toStrPointer(p.DevCommand),
-		Framework:                   toStrPointer(p.Framework),
-		InstallCommand:              toStrPointer(p.InstallCommand),
-		Name:                        name,
-		OutputDirectory:             toStrPointer(p.OutputDirectory),
-		PublicSource:                toBoolPointer(p.PublicSource),
-		RootDirectory:               toStrPointer(p.RootDirectory),
-		ServerlessFunctionRegion:    toStrPointer(p.ServerlessFunctionRegion),
-		PasswordProtection:          p.PasswordProtection.toUpdateProjectRequest(),
-		SSOProtection:               p.VercelAuthentication.toUpdateProjectRequest(),
+		AutoExposeSystemEnvs:            toBoolPointer(p.AutoExposeSystemEnvs),
+		BuildCommand:                    toStrPointer(p.BuildCommand),
+		CommandForIgnoringBuildStep:     toStrPointer(p.IgnoreCommand),
+		DevCommand:                      toStrPointer(p.DevCommand),
+		Framework:                       toStrPointer(p.Framework),
+		InstallCommand:                  toStrPointer(p.InstallCommand),
+		Name:                            name,
+		NodeVersion:                     toStrPointer(p.NodeVersion),
+		OutputDirectory:                 toStrPointer(p.OutputDirectory),
+		PublicSource:                    toBoolPointer(p.PublicSource),
+		ResourceConfig:                  p.toResourceConfig(),
+		RootDirectory:                   toStrPointer(p.RootDirectory),
+		ServerlessFunctionRegion:        toStrPointer(p.ServerlessFunctionRegion),
+		SkewProtectionMaxAge:            skewProtectionMaxAge,
+		SourceFilesOutsideRootDirectory: toBoolPointer(p.SourceFilesOutsideRootDirectory),
+		PasswordProtection:              p.PasswordProtection.toUpdateProjectRequest(),
+		SSOProtection:                   p.VercelAuthentication.toUpdateProjectRequest(),
+	}
+}
+
+// toResourceConfig returns the build and function resources configured for the project, or nil
+// if none are configured.
+func (p *Project) toResourceConfig() *client.ResourceConfig {
+	rc := client.ResourceConfig{
+		BuildMachineType:          toStrPointer(p.BuildMachineType),
+		FunctionDefaultMemoryType: toStrPointer(p.FunctionDefaultMemoryType),
+		FunctionDefaultTimeout:    toInt64Pointer(p.FunctionDefaultTimeout),
+	}
+	if rc == (client.ResourceConfig{}) {
+		return nil
 	}
+	return &rc
+}
+
+// requiresUpdateAfterCreation returns whether the project has settings that cannot be set when
+// a project is created, so must be set with a separate update.
+func (p *Project) requiresUpdateAfterCreation() bool {
+	known := func(v attr.Value) bool {
+		return !v.IsNull() && !v.IsUnknown()
+	}
+	return p.PasswordProtection != nil ||
+		p.VercelAuthentication != nil ||
+		known(p.AutoExposeSystemEnvs) ||
+		known(p.BuildMachineType) ||
+		known(p.FunctionDefaultMemoryType) ||
+		known(p.FunctionDefaultTimeout) ||
+		known(p.NodeVersion) ||
+		known(p.SkewProtectionMaxAge) ||
+		known(p.SourceFilesOutsideRootDirectory)
 }
 
 // EnvironmentItem reflects the state terraform stores internally for a project's environment variable.
 type EnvironmentItem struct {
-	Target    []types.String `tfsdk:"target"`
-	GitBranch types.String   `tfsdk:"git_branch"`
-	Key       types.String   `tfsdk:"key"`
-	Value     types.String   `tfsdk:"value"`
-	ID        types.String   `tfsdk:"id"`
+	Target               []types.String `tfsdk:"target"`
+	CustomEnvironmentIDs []types.String `tfsdk:"custom_environment_ids"`
+	GitBranch            types.String   `tfsdk:"git_branch"`
+	Key                  types.String   `tfsdk:"key"`
+	Value                types.String   `tfsdk:"value"`
+	Type                 types.String   `tfsdk:"type"`
+	Comment              types.String   `tfsdk:"comment"`
+	ID                   types.String   `tfsdk:"id"`
 }
 
 func (e *EnvironmentItem) toEnvironmentVariableRequest() client.EnvironmentVariableRequest {
-	target := []string{}
-	for _, t := range e.Target {
-		target = append(target, t.ValueString())
-	}
 	return client.EnvironmentVariableRequest{
-		Key:       e.Key.ValueString(),
-		Value:     e.Value.ValueString(),
-		Target:    target,
-		GitBranch: toStrPointer(e.GitBranch),
-		Type:      "encrypted",
+		Key:                  e.Key.ValueString(),
+		Value:                e.Value.ValueString(),
+		Target:               toStrings(e.Target),
+		CustomEnvironmentIDs: toStrings(e.CustomEnvironmentIDs),
+		GitBranch:            toStrPointer(e.GitBranch),
+		Type:                 e.Type.ValueString(),
+		Comment:              e.Comment.ValueString(),
+	}
+}
+
+func (e *EnvironmentItem) toUpdateEnvironmentVariableRequest(projectID, teamID string) client.UpdateEnvironmentVariableRequest {
+	return client.UpdateEnvironmentVariableRequest{
+		Key:                  e.Key.ValueString(),
+		Value:                e.Value.ValueString(),
+		Target:               toStrings(e.Target),
+		CustomEnvironmentIDs: toStrings(e.CustomEnvironmentIDs),
+		GitBranch:            toStrPointer(e.GitBranch),
+		Type:                 e.Type.ValueString(),
+		Comment:              e.Comment.ValueString(),
+		ProjectID:            projectID,
+		TeamID:               teamID,
+		EnvID:                e.ID.ValueString(),
 	}
 }
 
@@ -246,12 +307,17 @@ var envVariableElemType = types.ObjectType{
 		"target": types.SetType{
 			ElemType: types.StringType,
 		},
+		"custom_environment_ids": types.SetType{
+			ElemType: types.StringType,
+		},
 		"git_branch": types.StringType,
+		"type":       types.StringType,
+		"comment":    types.StringType,
 		"id":         types.StringType,
 	},
 }
 
-func convertResponseToProject(response client.ProjectResponse, plan Project) Project {
+func convertResponseToProject(ctx context.Context, response client.ProjectResponse, plan Project) Project {
 	fields := plan.coercedFields()
 
 	var gr *GitRepository
@@ -285,28 +351,48 @@ func convertResponseToProject(response client.ProjectResponse, plan Project) Pro
 		}
 	}
 
+	// Sensitive environment variable values can never be read back from Vercel, so the
+	// values from the plan are used instead.
+	planValues := map[string]types.String{}
+	planEnvs, err := plan.environment(ctx)
+	if err == nil {
+		for _, e := range planEnvs {
+			planValues[envVarIdentity(e)] = e.Value
+		}
+	}
+
 	var env []attr.Value
 	for _, e := range response.EnvironmentVariables {
 		target := []attr.Value{}
+		targetItems := []types.String{}
 		for _, t := range e.Target {
 			target = append(target, types.StringValue(t))
+			targetItems = append(targetItems, types.StringValue(t))
+		}
+		value := types.StringValue(e.Value)
+		if e.Type == "sensitive" {
+			value = types.StringValue("")
+			identity := envVarIdentity(EnvironmentItem{
+				Key:                  types.StringValue(e.Key),
+				Target:               targetItems,
+				CustomEnvironmentIDs: fromStrings(e.CustomEnvironmentIDs),
+				GitBranch:            fromStringPointer(e.GitBranch),
+			})
+			if v, ok := planValues[identity]; ok {
+				value = v
+			}
 		}
 		env = append(env, types.ObjectValueMust(
-			map[string]attr.Type{
-				"key":   types.StringType,
-				"value": types.StringType,
-				"target": types.SetType{
-					ElemType: types.StringType,
-				},
-				"git_branch": types.StringType,
-				"id":         types.StringType,
-			},
+			envVariableElemType.AttrTypes,
 			map[string]attr.Value{
-				"key":        types.StringValue(e.Key),
-				"value":      types.StringValue(e.Value),
-				"target":     types.SetValueMust(types.StringType, target),
-				"git_branch": fromStringPointer(e.GitBranch),
-				"id":         types.StringValue(e.ID),
+				"key":                    types.StringValue(e.Key),
+				"value":                  value,
+				"target":                 types.SetValueMust(types.StringType, target),
+				"custom_environment_ids": toOptionalStringSet(e.CustomEnvironmentIDs),
+				"git_branch":             fromStringPointer(e.GitBranch),
+				"type":                   types.StringValue(e.Type),
+				"comment":                fromOptionalString(e.Comment),
+				"id":                     types.StringValue(e.ID),
 			},
 		))
 	}
@@ -324,13 +410,31 @@ func convertResponseToProject(response client.ProjectResponse, plan Project) Pro
 		protectionBypass = types.BoolValue(false)
 	}
 
+	resourceConfig := client.ResourceConfig{}
+	if response.ResourceConfig != nil {
+		resourceConfig = *response.ResourceConfig
+	}
+
+	// Vercel reports a max age of 0 when skew protection is disabled.
+	skewProtectionMaxAge := types.Int64Null()
+	if response.SkewProtectionMaxAge != nil && *response.SkewProtectionMaxAge != 0 {
+		skewProtectionMaxAge = types.Int64Value(*response.SkewProtectionMaxAge)
+	}
+
 	environmentEntry := types.SetValueMust(envVariableElemType, env)
 	if len(response.EnvironmentVariables) == 0 && plan.Environment.IsNull() {
 		environmentEntry = types.SetNull(envVariableElemType)
 	}
 
 	return Project{
+		AutoExposeSystemEnvs:                fromBoolPointer(response.AutoExposeSystemEnvs),
 		BuildCommand:                        uncoerceString(fields.BuildCommand, fromStringPointer(response.BuildCommand)),
+		BuildMachineType:                    fromStringPointer(resourceConfig.BuildMachineType),
+		FunctionDefaultMemoryType:           fromStringPointer(resourceConfig.FunctionDefaultMemoryType),
+		FunctionDefaultTimeout:              fromInt64Pointer(resourceConfig.FunctionDefaultTimeout),
+		NodeVersion:                         fromStringPointer(response.NodeVersion),
+		SkewProtectionMaxAge:                skewProtectionMaxAge,
+		SourceFilesOutsideRootDirectory:     fromBoolPointer(response.SourceFilesOutsideRootDirectory),
 		DevCommand:                          uncoerceString(fields.DevCommand, fromStringPointer(response.DevCommand)),
 		Environment:                         environmentEntry,
 		Framework:                           fromStringPointer(response.Framework),
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -64,6 +65,17 @@ func TestAcc_Project(t *testing.T) {
 						"value": "bar",
 					}),
 					resource.TestCheckTypeSetElemAttr("vercel_project.test", "environment.0.target.*", "production"),
+					resource.TestCheckTypeSetElemNestedAttrs("vercel_project.test", "environment.*", map[string]string{
+						"key":     "two",
+						"value":   "bar",
+						"type":    "plain",
+						"comment": "a plain value",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("vercel_project.test", "environment.*", map[string]string{
+						"key":   "three",
+						"value": "bar",
+						"type":  "sensitive",
+					}),
 				),
 			},
 			// Update testing
@@ -105,6 +117,83 @@ func TestAcc_ProjectAddingEnvAfterInitialCreation(t *testing.T) {
 	})
 }
 
+func TestAcc_ProjectEnvironmentVariableUpdatesInPlace(t *testing.T) {
+	projectSuffix := acctest.RandString(16)
+	var projectID, envID string
+	captureEnv := func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources["vercel_project.test"]
+		if !ok {
+			return fmt.Errorf("not found: vercel_project.test")
+		}
+		projectID = rs.Primary.ID
+		project, err := testClient().GetProject(context.TODO(), projectID, testTeam(), true)
+		if err != nil {
+			return err
+		}
+		for _, e := range project.EnvironmentVariables {
+			if e.Key == "foo" {
+				envID = e.ID
+				return nil
+			}
+		}
+		return fmt.Errorf("environment variable foo not found")
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccProjectDestroy("vercel_project.test", testTeam()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectConfigWithEnvValue(projectSuffix, teamIDConfig(), "bar"),
+				Check:  captureEnv,
+			},
+			// Changing the value should update the existing environment variable.
+			{
+				Config: testAccProjectConfigWithEnvValue(projectSuffix, teamIDConfig(), "baz"),
+				Check: func(s *terraform.State) error {
+					// The ID is only known once the first step has run.
+					return resource.TestCheckTypeSetElemNestedAttrs("vercel_project.test", "environment.*", map[string]string{
+						"key":   "foo",
+						"value": "baz",
+						"id":    envID,
+					})(s)
+				},
+			},
+			// Values changed outside of terraform should be reported as drift.
+			{
+				PreConfig: func() {
+					_, err := testClient().UpdateEnvironmentVariable(context.TODO(), client.UpdateEnvironmentVariableRequest{
+						Key:       "foo",
+						Value:     "changed outside terraform",
+						Target:    []string{"production"},
+						Type:      "encrypted",
+						ProjectID: projectID,
+						TeamID:    testTeam(),
+						EnvID:     envID,
+					})
+					if err != nil {
+						t.Fatalf("unexpected error updating environment variable: %s", err)
+					}
+				},
+				Config:             testAccProjectConfigWithEnvValue(projectSuffix, teamIDConfig(), "baz"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccProjectConfigWithEnvValue(projectSuffix, teamIDConfig(), "baz"),
+				Check: func(s *terraform.State) error {
+					// The ID is only known once the first step has run.
+					return resource.TestCheckTypeSetElemNestedAttrs("vercel_project.test", "environment.*", map[string]string{
+						"key":   "foo",
+						"value": "baz",
+						"id":    envID,
+					})(s)
+				},
+			},
+		},
+	})
+}
+
 func TestAcc_ProjectWithGitRepository(t *testing.T) {
 	projectSuffix := acctest.RandString(16)
 	resource.Test(t, resource.TestCase{
@@ -211,6 +300,53 @@ func getProjectImportID(n string) resource.ImportStateIdFunc {
 	}
 }
 
+func TestAcc_ProjectBuildAndRuntimeSettings(t *testing.T) {
+	projectSuffix := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccProjectDestroy("vercel_project.test", testTeam()),
+		Steps: []resource.TestStep{
+			{
+				Config: projectConfigWithSettings(projectSuffix, teamIDConfig()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectExists("vercel_project.test", testTeam()),
+					resource.TestCheckResourceAttr("vercel_project.test", "node_version", "18.x"),
+					resource.TestCheckResourceAttr("vercel_project.test", "build_machine_type", "enhanced"),
+					resource.TestCheckResourceAttr("vercel_project.test", "function_default_memory_type", "performance"),
+					resource.TestCheckResourceAttr("vercel_project.test", "function_default_timeout", "60"),
+					resource.TestCheckResourceAttr("vercel_project.test", "skew_protection_max_age", "3600"),
+					resource.TestCheckResourceAttr("vercel_project.test", "auto_expose_system_envs", "false"),
+					resource.TestCheckResourceAttr("vercel_project.test", "source_files_outside_root_directory", "false"),
+				),
+			},
+			{
+				Config: projectConfigWithoutEnv(projectSuffix, teamIDConfig()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_project.test", "node_version", "18.x"),
+					resource.TestCheckNoResourceAttr("vercel_project.test", "skew_protection_max_age"),
+				),
+			},
+		},
+	})
+}
+
+func projectConfigWithSettings(projectSuffix, teamID string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "test-acc-project-%s"
+  %s
+  node_version                        = "18.x"
+  build_machine_type                  = "enhanced"
+  function_default_memory_type        = "performance"
+  function_default_timeout            = 60
+  skew_protection_max_age             = 3600
+  auto_expose_system_envs             = false
+  source_files_outside_root_directory = false
+}
+`, projectSuffix, teamID)
+}
+
 func TestAcc_ProjectImport(t *testing.T) {
 	projectSuffix := acctest.RandString(16)
 	resource.Test(t, resource.TestCase{
@@ -234,6 +370,51 @@ func TestAcc_ProjectImport(t *testing.T) {
 	})
 }
 
+func TestAcc_ProjectImportWithEnvironment(t *testing.T) {
+	projectSuffix := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccProjectDestroy("vercel_project.test", testTeam()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectConfig(projectSuffix, teamIDConfig()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectExists("vercel_project.test", testTeam()),
+				),
+			},
+			{
+				ResourceName: "vercel_project.test",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					id, err := getProjectImportID("vercel_project.test")(s)
+					return id + "#environment", err
+				},
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 imported project, got %d", len(states))
+					}
+					attributes := states[0].Attributes
+					if attributes["environment.#"] != "6" {
+						return fmt.Errorf("expected 6 environment variables to be imported, got %s", attributes["environment.#"])
+					}
+					for k, v := range attributes {
+						if !strings.HasSuffix(k, ".key") || v != "three" {
+							continue
+						}
+						prefix := strings.TrimSuffix(k, ".key")
+						if attributes[prefix+".type"] != "sensitive" || attributes[prefix+".value"] != "" {
+							return fmt.Errorf("expected the sensitive environment variable to be imported without a value, got type %s", attributes[prefix+".type"])
+						}
+						return nil
+					}
+					return fmt.Errorf("sensitive environment variable was not imported")
+				},
+			},
+		},
+	})
+}
+
 func testAccProjectExists(n, teamID string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -323,6 +504,27 @@ resource "vercel_project" "test" {
 `, projectSuffix, teamID)
 }
 
+func testAccProjectConfigWithEnvValue(projectSuffix, teamID, value string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "test-acc-env-%s"
+  %s
+  environment = [
+    {
+      key    = "foo"
+      value  = "%s"
+      target = ["production"]
+    },
+    {
+      key    = "bar"
+      value  = "baz"
+      target = ["production", "preview"]
+    }
+  ]
+}
+`, projectSuffix, teamID, value)
+}
+
 func testAccProjectConfigWithSSOAndPassword(projectSuffix, teamID string) string {
 	return fmt.Sprintf(`
 resource "vercel_project" "enabled_to_start" {
@@ -475,14 +677,17 @@ resource "vercel_project" "test" {
       target = ["production"]
     },
     {
-      key    = "two"
-      value  = "bar"
-      target = ["production"]
+      key     = "two"
+      value   = "bar"
+      target  = ["production"]
+      type    = "plain"
+      comment = "a plain value"
     },
     {
       key    = "three"
       value  = "bar"
       target = ["production"]
+      type   = "sensitive"
     },
     {
       key    = "baz"
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -80,6 +81,23 @@ For more detailed information, please see the [Vercel documentation](https://ver
 				Description: "The value of the Environment Variable.",
 				Sensitive:   true,
 			},
+			"type": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "The type of the Environment Variable. Must be one of `plain`, `encrypted` or `sensitive`. The value of a `sensitive` Environment Variable cannot be read back from Vercel, so changes made to it outside of Terraform will not be detected. Defaults to `encrypted`.",
+				Default:       stringdefault.StaticString("encrypted"),
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators: []validator.String{
+					stringOneOf("plain", "encrypted", "sensitive"),
+				},
+			},
+			"comment": schema.StringAttribute{
+				Optional:    true,
+				Description: "A comment explaining what the Environment Variable is for.",
+				Validators: []validator.String{
+					stringLengthBetween(1, 500),
+				},
+			},
 			"project_ids": schema.SetAttribute{
 				Required:    true,
 				Description: "The ID of the Vercel project.",
@@ -114,12 +132,12 @@ func (r *sharedEnvironmentVariableResource) Create(ctx context.Context, req reso
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating project environment variable",
-			"Could not create project environment variable, unexpected error: "+err.Error(),
+			"Could not create project environment variable, unexpected error: "+describeError(err),
 		)
 		return
 	}
 
-	result := convertResponseToSharedEnvironmentVariable(response)
+	result := convertResponseToSharedEnvironmentVariable(response, plan.Value)
 
 	tflog.Trace(ctx, "created shared environment variable", map[string]interface{}{
 		"id":      result.ID.ValueString(),
@@ -154,13 +172,13 @@ func (r *sharedEnvironmentVariableResource) Read(ctx context.Context, req resour
 			fmt.Sprintf("Could not get shared environment variable %s %s, unexpected error: %s",
 				state.TeamID.ValueString(),
 				state.ID.ValueString(),
-				err,
+				describeError(err),
 			),
 		)
 		return
 	}
 
-	result := convertResponseToSharedEnvironmentVariable(out)
+	result := convertResponseToSharedEnvironmentVariable(out, state.Value)
 	tflog.Trace(ctx, "read shared environment variable", map[string]interface{}{
 		"id":      result.ID.ValueString(),
 		"team_id": result.TeamID.ValueString(),
@@ -186,12 +204,12 @@ func (r *sharedEnvironmentVariableResource) Update(ctx context.Context, req reso
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating shared environment variable",
-			"Could not update shared environment variable, unexpected error: "+err.Error(),
+			"Could not update shared environment variable, unexpected error: "+describeError(err),
 		)
 		return
 	}
 
-	result := convertResponseToSharedEnvironmentVariable(response)
+	result := convertResponseToSharedEnvironmentVariable(response, plan.Value)
 
 	tflog.Trace(ctx, "updated project environment variable", map[string]interface{}{
 		"id":      result.ID.ValueString(),
@@ -224,7 +242,7 @@ func (r *sharedEnvironmentVariableResource) Delete(ctx context.Context, req reso
 			fmt.Sprintf(
 				"Could not delete shared environment variable %s, unexpected error: %s",
 				state.ID.ValueString(),
-				err,
+				describeError(err),
 			),
 		)
 		return
@@ -265,13 +283,13 @@ func (r *sharedEnvironmentVariableResource) ImportState(ctx context.Context, req
 			fmt.Sprintf("Could not get shared environment variable %s %s, unexpected error: %s",
 				teamID,
 				envID,
-				err,
+				describeError(err),
 			),
 		)
 		return
 	}
 
-	result := convertResponseToSharedEnvironmentVariable(out)
+	result := convertResponseToSharedEnvironmentVariable(out, types.StringNull())
 	tflog.Trace(ctx, "imported shared environment variable", map[string]interface{}{
 		"team_id": result.TeamID.ValueString(),
 		"env_id":  result.ID.ValueString(),
@@ -10,6 +10,8 @@ type SharedEnvironmentVariable struct {
 	Target     []types.String `tfsdk:"target"`
 	Key        types.String   `tfsdk:"key"`
 	Value      types.String   `tfsdk:"value"`
+	Type       types.String   `tfsdk:"type"`
+	Comment    types.String   `tfsdk:"comment"`
 	TeamID     types.String   `tfsdk:"team_id"`
 	ProjectIDs []types.String `tfsdk:"project_ids"`
 	ID         types.String   `tfsdk:"id"`
@@ -27,12 +29,13 @@ func (e *SharedEnvironmentVariable) toCreateSharedEnvironmentVariableRequest() c
 	return client.CreateSharedEnvironmentVariableRequest{
 		EnvironmentVariable: client.SharedEnvironmentVariableRequest{
 			Target:     target,
-			Type:       "encrypted",
+			Type:       e.Type.ValueString(),
 			ProjectIDs: projectIDs,
 			EnvironmentVariables: []client.SharedEnvVarRequest{
 				{
-					Key:   e.Key.ValueString(),
-					Value: e.Value.ValueString(),
+					Key:     e.Key.ValueString(),
+					Value:   e.Value.ValueString(),
+					Comment: e.Comment.ValueString(),
 				},
 			},
 		},
@@ -53,7 +56,8 @@ func (e *SharedEnvironmentVariable) toUpdateSharedEnvironmentVariableRequest() c
 		Key:        e.Key.ValueString(),
 		Value:      e.Value.ValueString(),
 		Target:     target,
-		Type:       "encrypted",
+		Type:       e.Type.ValueString(),
+		Comment:    e.Comment.ValueString(),
 		TeamID:     e.TeamID.ValueString(),
 		EnvID:      e.ID.ValueString(),
 		ProjectIDs: projectIDs,
@@ -62,12 +66,16 @@ func (e *SharedEnvironmentVariable) toUpdateSharedEnvironmentVariableRequest() c
 
 // convertResponseToSharedEnvironmentVariable is used to populate terraform state based on an API response.
 // Where possible, values from the API response are used to populate state. If not possible,
-// values from plan are used.
-func convertResponseToSharedEnvironmentVariable(response client.SharedEnvironmentVariableResponse) SharedEnvironmentVariable {
+// values from plan are used. The value of a sensitive environment variable can never be read
+// from the API, so the value passed in is used instead.
+func convertResponseToSharedEnvironmentVariable(response client.SharedEnvironmentVariableResponse, value types.String) SharedEnvironmentVariable {
 	target := []types.String{}
 	for _, t := range response.Target {
 		target = append(target, types.StringValue(t))
 	}
+	if response.Type != "sensitive" {
+		value = types.StringValue(response.Value)
+	}
 
 	project_ids := []types.String{}
 	for _, t := range response.ProjectIDs {
@@ -77,7 +85,9 @@ func convertResponseToSharedEnvironmentVariable(response client.SharedEnvironmen
 	return SharedEnvironmentVariable{
 		Target:     target,
 		Key:        types.StringValue(response.Key),
-		Value:      types.StringValue(response.Value),
+		Value:      value,
+		Type:       types.StringValue(response.Type),
+		Comment:    fromOptionalString(response.Comment),
 		ProjectIDs: project_ids,
 		TeamID:     toTeamID(response.TeamID),
 		ID:         types.StringValue(response.ID),
@@ -62,6 +62,10 @@ func TestAcc_SharedEnvironmentVariables(t *testing.T) {
 					resource.TestCheckResourceAttr("vercel_shared_environment_variable.example", "key", "foo"),
 					resource.TestCheckResourceAttr("vercel_shared_environment_variable.example", "value", "bar"),
 					resource.TestCheckTypeSetElemAttr("vercel_shared_environment_variable.example", "target.*", "production"),
+					resource.TestCheckResourceAttr("vercel_shared_environment_variable.example", "type", "encrypted"),
+					testAccSharedEnvironmentVariableExists("vercel_shared_environment_variable.sensitive", testTeam()),
+					resource.TestCheckResourceAttr("vercel_shared_environment_variable.sensitive", "value", "secret"),
+					resource.TestCheckResourceAttr("vercel_shared_environment_variable.sensitive", "type", "sensitive"),
 				),
 			},
 			{
@@ -72,6 +76,8 @@ func TestAcc_SharedEnvironmentVariables(t *testing.T) {
 					resource.TestCheckResourceAttr("vercel_shared_environment_variable.example", "value", "updated-bar"),
 					resource.TestCheckTypeSetElemAttr("vercel_shared_environment_variable.example", "target.*", "development"),
 					resource.TestCheckTypeSetElemAttr("vercel_shared_environment_variable.example", "target.*", "preview"),
+					resource.TestCheckResourceAttr("vercel_shared_environment_variable.example", "comment", "an updated comment"),
+					resource.TestCheckResourceAttr("vercel_shared_environment_variable.sensitive", "value", "updated-secret"),
 				),
 			},
 			{
@@ -80,6 +86,14 @@ func TestAcc_SharedEnvironmentVariables(t *testing.T) {
 				ImportStateVerify: true,
 				ImportStateIdFunc: getSharedEnvironmentVariableImportID("vercel_shared_environment_variable.example"),
 			},
+			{
+				ResourceName:      "vercel_shared_environment_variable.sensitive",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getSharedEnvironmentVariableImportID("vercel_shared_environment_variable.sensitive"),
+				// The value of a sensitive environment variable cannot be read back.
+				ImportStateVerifyIgnore: []string{"value"},
+			},
 			{
 				Config: testAccSharedEnvironmentVariablesConfigDeleted(nameSuffix),
 				Check: resource.ComposeAggregateTestCheckFunc(
@@ -121,6 +135,17 @@ resource "vercel_shared_environment_variable" "example" {
         vercel_project.example.id
     ]
 }
+
+resource "vercel_shared_environment_variable" "sensitive" {
+	%[2]s
+	key         = "sensitive"
+	value       = "secret"
+	type        = "sensitive"
+	target      = ["production"]
+    project_ids = [
+        vercel_project.example.id
+    ]
+}
 `, projectName, teamIDConfig())
 }
 
@@ -140,12 +165,24 @@ resource "vercel_shared_environment_variable" "example" {
 	%[2]s
 	key         = "foo"
 	value       = "updated-bar"
+	comment     = "an updated comment"
 	target      = ["preview", "development"]
     project_ids = [
         vercel_project.example.id,
         vercel_project.example2.id
     ]
 }
+
+resource "vercel_shared_environment_variable" "sensitive" {
+	%[2]s
+	key         = "sensitive"
+	value       = "updated-secret"
+	type        = "sensitive"
+	target      = ["production"]
+    project_ids = [
+        vercel_project.example.id
+    ]
+}
 `, projectName, teamIDConfig())
 }
 
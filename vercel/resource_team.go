@@ -0,0 +1,343 @@
+package vercel
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+var (
+	_ resource.Resource                = &teamResource{}
+	_ resource.ResourceWithConfigure   = &teamResource{}
+	_ resource.ResourceWithImportState = &teamResource{}
+)
+
+func newTeamResource() resource.Resource {
+	return &teamResource{}
+}
+
+type teamResource struct {
+	client *client.Client
+}
+
+func (r *teamResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team"
+}
+
+func (r *teamResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema returns the schema information for a team resource.
+func (r *teamResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides a Team resource.
+
+A Team allows multiple users to collaborate on the same projects, deployments and domains.
+
+For more detailed information, please see the [Vercel documentation](https://vercel.com/docs/accounts/create-a-team).
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				Description:   "The ID of the team. This can be used as the `team_id` of other resources.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"slug": schema.StringAttribute{
+				Required:    true,
+				Description: "The slug of the team, which is used in the URL of the team's dashboard. This must be unique across Vercel.",
+				Validators: []validator.String{
+					stringLengthBetween(1, 48),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "The name of the team. Defaults to the slug of the team.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators: []validator.String{
+					stringLengthBetween(1, 256),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "A description of the team.",
+				Validators: []validator.String{
+					stringLengthBetween(1, 140),
+				},
+			},
+			"avatar": schema.StringAttribute{
+				Optional:    true,
+				Description: "The path to an image file to upload as the avatar of the team. Removing this leaves the current avatar in place.",
+			},
+			"avatar_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the avatar of the team, if one has been set.",
+			},
+			"default_vercel_authentication": schema.SingleNestedAttribute{
+				Description: "The Vercel Authentication (SSO protection) applied by default to new projects within the team. This ensures visitors to Preview Deployments are logged into Vercel and have a minimum of Viewer access on the team.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"protect_production": schema.BoolAttribute{
+						Description: "If true, production deployments will also be protected",
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+					},
+				},
+			},
+		},
+	}
+}
+
+// uploadAvatar uploads the avatar image of a team to Vercel, if it has changed, returning its ID.
+func (r *teamResource) uploadAvatar(ctx context.Context, plan Team, state *Team) (*string, error) {
+	if plan.Avatar.IsNull() {
+		return nil, nil
+	}
+	path := plan.Avatar.ValueString()
+	sha, content, err := readAvatar(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read avatar: %w", err)
+	}
+	if state != nil && state.AvatarID.ValueString() == sha {
+		return nil, nil
+	}
+	err = r.client.CreateFile(ctx, client.CreateFileRequest{
+		Filename: filepath.Base(path),
+		SHA:      sha,
+		Content:  bytes.NewReader(content),
+		Size:     int64(len(content)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to upload avatar: %w", err)
+	}
+	return &sha, nil
+}
+
+// Create will create a team within Vercel.
+// This is called automatically by the provider when a new resource should be created.
+func (r *teamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan Team
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	avatarID, err := r.uploadAvatar(ctx, plan, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating team",
+			"Could not create team, unexpected error: "+describeError(err),
+		)
+		return
+	}
+
+	team, err := r.client.CreateTeam(ctx, client.TeamCreateRequest{
+		Slug: plan.Slug.ValueString(),
+		Name: plan.Name.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating team",
+			"Could not create team, unexpected error: "+describeError(err),
+		)
+		return
+	}
+
+	// The team exists from this point on, so it is stored in state before the remaining settings are
+	// applied. If they fail to apply, terraform then marks the team as tainted rather than losing track
+	// of it.
+	diags = resp.State.Set(ctx, convertResponseToTeam(team, plan.Avatar))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The remaining settings of a team can only be set once it exists.
+	out, err := r.client.UpdateTeam(ctx, team.ID, plan.toTeamUpdateRequest(avatarID))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating team",
+			fmt.Sprintf("Could not update team %s after creation, unexpected error: %s", team.ID, describeError(err)),
+		)
+		return
+	}
+
+	result := convertResponseToTeam(out, plan.Avatar)
+	tflog.Trace(ctx, "created team", map[string]interface{}{
+		"team_id": result.ID.ValueString(),
+		"slug":    result.Slug.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read will read a team from the vercel API and provide terraform with information about it.
+// It is called by the provider whenever values should be read to update state.
+func (r *teamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Team
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := r.client.GetTeam(ctx, state.ID.ValueString())
+	if client.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading team",
+			fmt.Sprintf("Could not read team %s, unexpected error: %s",
+				state.ID.ValueString(),
+				describeError(err),
+			),
+		)
+		return
+	}
+
+	result := convertResponseToTeam(out, state.Avatar)
+	tflog.Trace(ctx, "read team", map[string]interface{}{
+		"team_id": result.ID.ValueString(),
+		"slug":    result.Slug.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update will update a team via the vercel API.
+func (r *teamResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan Team
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state Team
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	avatarID, err := r.uploadAvatar(ctx, plan, &state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating team",
+			fmt.Sprintf("Could not update team %s, unexpected error: %s", state.ID.ValueString(), describeError(err)),
+		)
+		return
+	}
+
+	out, err := r.client.UpdateTeam(ctx, state.ID.ValueString(), plan.toTeamUpdateRequest(avatarID))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating team",
+			fmt.Sprintf("Could not update team %s, unexpected error: %s", state.ID.ValueString(), describeError(err)),
+		)
+		return
+	}
+
+	result := convertResponseToTeam(out, plan.Avatar)
+	tflog.Trace(ctx, "updated team", map[string]interface{}{
+		"team_id": result.ID.ValueString(),
+		"slug":    result.Slug.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete a team from within terraform. This deletes all of the projects, deployments and domains within the team.
+func (r *teamResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Team
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteTeam(ctx, state.ID.ValueString())
+	if client.NotFound(err) {
+		// The team is already gone - do nothing.
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting team",
+			fmt.Sprintf("Could not delete team %s, unexpected error: %s", state.ID.ValueString(), describeError(err)),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted team", map[string]interface{}{
+		"team_id": state.ID.ValueString(),
+	})
+}
+
+// ImportState takes an identifier and reads all the team information from the Vercel API.
+// Teams can be imported by either their ID or their slug.
+func (r *teamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	out, err := r.client.GetTeam(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading team",
+			fmt.Sprintf("Could not get team %s, unexpected error: %s", req.ID, describeError(err)),
+		)
+		return
+	}
+
+	result := convertResponseToTeam(out, types.StringNull())
+	tflog.Trace(ctx, "imported team", map[string]interface{}{
+		"team_id": result.ID.ValueString(),
+		"slug":    result.Slug.ValueString(),
+	})
+
+	diags := resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,337 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+var (
+	_ resource.Resource                   = &teamMemberResource{}
+	_ resource.ResourceWithConfigure      = &teamMemberResource{}
+	_ resource.ResourceWithImportState    = &teamMemberResource{}
+	_ resource.ResourceWithValidateConfig = &teamMemberResource{}
+)
+
+func newTeamMemberResource() resource.Resource {
+	return &teamMemberResource{}
+}
+
+type teamMemberResource struct {
+	client *client.Client
+}
+
+func (r *teamMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_member"
+}
+
+func (r *teamMemberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema returns the schema information for a team member resource.
+func (r *teamMemberResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides a Team Member resource.
+
+A Team Member is a user that has access to a Vercel Team. Users are invited to the team, either by their user ID
+or by their email address, and given a role that determines what they can do within the team.
+
+Team Members are read from the list of the team's members, which includes invitations that have not yet been
+accepted. If Vercel stops listing a pending invitation, the user is invited again on the next apply.
+
+For more detailed information, please see the [Vercel documentation](https://vercel.com/docs/accounts/team-members-and-roles).
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"team_id": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "The ID of the team the member should be added to. Required if a default team has not been set in the provider.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace(), stringplanmodifier.UseStateForUnknown()},
+			},
+			"user_id": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "The ID of the user to add to the team. Exactly one of `user_id` or `email` must be specified.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace(), stringplanmodifier.UseStateForUnknown()},
+			},
+			"email": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "The email address of the user to invite to the team. Exactly one of `user_id` or `email` must be specified.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace(), stringplanmodifier.UseStateForUnknown()},
+			},
+			"role": schema.StringAttribute{
+				Required:    true,
+				Description: "The role of the member within the team. Must be one of `OWNER`, `MEMBER`, `DEVELOPER`, `VIEWER` or `BILLING`.",
+				Validators: []validator.String{
+					stringOneOf("OWNER", "MEMBER", "DEVELOPER", "VIEWER", "BILLING"),
+				},
+			},
+			"confirmed": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the user has accepted the invitation to join the team.",
+			},
+		},
+	}
+}
+
+// ValidateConfig validates the Resource configuration.
+func (r *teamMemberResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config TeamMember
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.UserID.IsNull() && !config.Email.IsNull() {
+		resp.Diagnostics.AddError(
+			"Team Member Invalid",
+			"A Team Member cannot have both `user_id` and `email` specified",
+		)
+		return
+	}
+	if config.UserID.IsNull() && config.Email.IsNull() {
+		resp.Diagnostics.AddError(
+			"Team Member Invalid",
+			"A Team Member must have either `user_id` or `email` specified",
+		)
+		return
+	}
+}
+
+// Create will invite a user to a team within Vercel.
+// This is called automatically by the provider when a new resource should be created.
+func (r *teamMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TeamMember
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID := r.client.TeamID(plan.TeamID.ValueString())
+	if teamID == "" {
+		resp.Diagnostics.AddError(
+			"Error creating team member",
+			"A `team_id` must be specified when a default team has not been set in the provider",
+		)
+		return
+	}
+
+	out, err := r.client.CreateTeamMember(ctx, teamID, plan.toTeamMemberCreateRequest())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating team member",
+			"Could not create team member, unexpected error: "+describeError(err),
+		)
+		return
+	}
+
+	result := convertResponseToTeamMember(out, plan.Email)
+	tflog.Trace(ctx, "created team member", map[string]interface{}{
+		"team_id": result.TeamID.ValueString(),
+		"user_id": result.UserID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read will read a team member from the vercel API and provide terraform with information about it.
+// It is called by the provider whenever values should be read to update state.
+func (r *teamMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TeamMember
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := r.client.GetTeamMember(ctx, state.TeamID.ValueString(), state.UserID.ValueString())
+	if client.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading team member",
+			fmt.Sprintf("Could not read team member %s %s, unexpected error: %s",
+				state.TeamID.ValueString(),
+				state.UserID.ValueString(),
+				describeError(err),
+			),
+		)
+		return
+	}
+
+	result := convertResponseToTeamMember(out, state.Email)
+	tflog.Trace(ctx, "read team member", map[string]interface{}{
+		"team_id": result.TeamID.ValueString(),
+		"user_id": result.UserID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update will update the role of a team member via the vercel API.
+func (r *teamMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TeamMember
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state TeamMember
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UpdateTeamMember(ctx, state.TeamID.ValueString(), state.UserID.ValueString(), client.TeamMemberUpdateRequest{
+		Role: plan.Role.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating team member",
+			fmt.Sprintf("Could not update team member %s %s, unexpected error: %s",
+				state.TeamID.ValueString(),
+				state.UserID.ValueString(),
+				describeError(err),
+			),
+		)
+		return
+	}
+
+	out, err := r.client.GetTeamMember(ctx, state.TeamID.ValueString(), state.UserID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading team member",
+			fmt.Sprintf("Could not read team member %s %s after update, unexpected error: %s",
+				state.TeamID.ValueString(),
+				state.UserID.ValueString(),
+				describeError(err),
+			),
+		)
+		return
+	}
+
+	result := convertResponseToTeamMember(out, plan.Email)
+	tflog.Trace(ctx, "updated team member", map[string]interface{}{
+		"team_id": result.TeamID.ValueString(),
+		"user_id": result.UserID.ValueString(),
+		"role":    result.Role.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes a member from a team.
+func (r *teamMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TeamMember
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteTeamMember(ctx, state.TeamID.ValueString(), state.UserID.ValueString())
+	if client.NotFound(err) {
+		// The member has already been removed - do nothing.
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting team member",
+			fmt.Sprintf("Could not delete team member %s %s, unexpected error: %s",
+				state.TeamID.ValueString(),
+				state.UserID.ValueString(),
+				describeError(err),
+			),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted team member", map[string]interface{}{
+		"team_id": state.TeamID.ValueString(),
+		"user_id": state.UserID.ValueString(),
+	})
+}
+
+// ImportState takes an identifier and reads all the team member information from the Vercel API.
+func (r *teamMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	teamID, userID, ok := splitID(req.ID)
+	if !ok || r.client.TeamID(teamID) == "" {
+		resp.Diagnostics.AddError(
+			"Error importing team member",
+			fmt.Sprintf("Invalid id '%s' specified. should be in format \"team_id/user_id\"", req.ID),
+		)
+		return
+	}
+
+	out, err := r.client.GetTeamMember(ctx, teamID, userID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading team member",
+			fmt.Sprintf("Could not get team member %s %s, unexpected error: %s",
+				teamID,
+				userID,
+				describeError(err),
+			),
+		)
+		return
+	}
+
+	result := convertResponseToTeamMember(out, types.StringNull())
+	tflog.Trace(ctx, "imported team member", map[string]interface{}{
+		"team_id": result.TeamID.ValueString(),
+		"user_id": result.UserID.ValueString(),
+	})
+
+	diags := resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
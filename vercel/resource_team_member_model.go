@@ -0,0 +1,44 @@
+package vercel
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+// TeamMember reflects the state terraform stores internally for a member of a team.
+type TeamMember struct {
+	ID        types.String `tfsdk:"id"`
+	TeamID    types.String `tfsdk:"team_id"`
+	UserID    types.String `tfsdk:"user_id"`
+	Email     types.String `tfsdk:"email"`
+	Role      types.String `tfsdk:"role"`
+	Confirmed types.Bool   `tfsdk:"confirmed"`
+}
+
+func (m *TeamMember) toTeamMemberCreateRequest() client.TeamMemberCreateRequest {
+	return client.TeamMemberCreateRequest{
+		UserID: m.UserID.ValueString(),
+		Email:  m.Email.ValueString(),
+		Role:   m.Role.ValueString(),
+	}
+}
+
+// convertResponseToTeamMember converts a team member from the vercel API into the format terraform
+// uses. Vercel may change the case of an email address, so the configured email is kept if it only
+// differs by case.
+func convertResponseToTeamMember(response client.TeamMemberResponse, email types.String) TeamMember {
+	if email.IsNull() || email.IsUnknown() || !strings.EqualFold(email.ValueString(), response.Email) {
+		email = types.StringValue(response.Email)
+	}
+	return TeamMember{
+		ID:        types.StringValue(fmt.Sprintf("%s/%s", response.TeamID, response.UserID)),
+		TeamID:    types.StringValue(response.TeamID),
+		UserID:    types.StringValue(response.UserID),
+		Email:     email,
+		Role:      types.StringValue(response.Role),
+		Confirmed: types.BoolValue(response.Confirmed),
+	}
+}
@@ -0,0 +1,84 @@
+package vercel_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccTeamMemberExists(n string, teamID, userID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		*teamID = rs.Primary.Attributes["team_id"]
+		*userID = rs.Primary.Attributes["user_id"]
+		_, err := testClient().GetTeamMember(context.TODO(), *teamID, *userID)
+		return err
+	}
+}
+
+func TestAcc_TeamMember(t *testing.T) {
+	suffix := acctest.RandString(16)
+	var teamID, userID string
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccTeamDestroy("vercel_team.test"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamMemberConfig(suffix, "DEVELOPER"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccTeamMemberExists("vercel_team_member.test", &teamID, &userID),
+					resource.TestCheckResourceAttrPair("vercel_team_member.test", "team_id", "vercel_team.test", "id"),
+					resource.TestCheckResourceAttrSet("vercel_team_member.test", "user_id"),
+					resource.TestCheckResourceAttr("vercel_team_member.test", "email", fmt.Sprintf("test-acc-%s@example.com", suffix)),
+					resource.TestCheckResourceAttr("vercel_team_member.test", "role", "DEVELOPER"),
+				),
+			},
+			{
+				ResourceName:      "vercel_team_member.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTeamMemberConfig(suffix, "VIEWER"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccTeamMemberExists("vercel_team_member.test", &teamID, &userID),
+					resource.TestCheckResourceAttr("vercel_team_member.test", "role", "VIEWER"),
+				),
+			},
+			{
+				// Removing the member outside of terraform should cause it to be added again.
+				PreConfig: func() {
+					if err := testClient().DeleteTeamMember(context.TODO(), teamID, userID); err != nil {
+						t.Fatalf("unexpected error removing team member: %s", err)
+					}
+				},
+				Config:             testAccTeamMemberConfig(suffix, "VIEWER"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccTeamMemberConfig(suffix, role string) string {
+	return fmt.Sprintf(`
+resource "vercel_team" "test" {
+  slug = "test-acc-team-member-%[1]s"
+}
+
+resource "vercel_team_member" "test" {
+  team_id = vercel_team.test.id
+  email   = "test-acc-%[1]s@example.com"
+  role    = "%[2]s"
+}
+`, suffix, role)
+}
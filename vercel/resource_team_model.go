@@ -0,0 +1,77 @@
+package vercel
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+// Team reflects the state terraform stores internally for a team.
+type Team struct {
+	ID                          types.String          `tfsdk:"id"`
+	Slug                        types.String          `tfsdk:"slug"`
+	Name                        types.String          `tfsdk:"name"`
+	Description                 types.String          `tfsdk:"description"`
+	Avatar                      types.String          `tfsdk:"avatar"`
+	AvatarID                    types.String          `tfsdk:"avatar_id"`
+	DefaultVercelAuthentication *VercelAuthentication `tfsdk:"default_vercel_authentication"`
+}
+
+func (t *Team) toTeamUpdateRequest(avatarID *string) client.TeamUpdateRequest {
+	return client.TeamUpdateRequest{
+		Slug: toStrPointer(t.Slug),
+		Name: toStrPointer(t.Name),
+		// An empty description removes any existing description.
+		Description: toPtr(t.Description.ValueString()),
+		Avatar:      avatarID,
+		DefaultDeploymentProtection: &client.TeamDeploymentProtection{
+			SSOProtection: t.DefaultVercelAuthentication.toUpdateProjectRequest(),
+		},
+	}
+}
+
+// readAvatar reads an avatar image, along with the SHA1 of its content that Vercel uses to identify it.
+func readAvatar(path string) (sha string, content []byte, err error) {
+	content, err = os.ReadFile(path)
+	if err != nil {
+		return "", nil, err
+	}
+	sum := sha1.Sum(content)
+	return hex.EncodeToString(sum[:]), content, nil
+}
+
+func convertResponseToTeam(response client.TeamResponse, avatar types.String) Team {
+	var va *VercelAuthentication
+	if response.DefaultDeploymentProtection != nil && response.DefaultDeploymentProtection.SSOProtection != nil {
+		va = &VercelAuthentication{
+			ProtectProduction: types.BoolValue(response.DefaultDeploymentProtection.SSOProtection.DeploymentType == "all"),
+		}
+	}
+
+	// The avatar is configured as a local file, so it can only be compared with Vercel by its content.
+	// If the avatar has been changed outside of terraform, the file needs to be uploaded again.
+	if !avatar.IsNull() && !avatar.IsUnknown() {
+		sha, _, err := readAvatar(avatar.ValueString())
+		if err == nil && (response.Avatar == nil || *response.Avatar != sha) {
+			avatar = types.StringNull()
+		}
+	}
+
+	description := types.StringNull()
+	if response.Description != nil && *response.Description != "" {
+		description = types.StringValue(*response.Description)
+	}
+
+	return Team{
+		ID:                          types.StringValue(response.ID),
+		Slug:                        types.StringValue(response.Slug),
+		Name:                        types.StringValue(response.Name),
+		Description:                 description,
+		Avatar:                      avatar,
+		AvatarID:                    fromStringPointer(response.Avatar),
+		DefaultVercelAuthentication: va,
+	}
+}
@@ -0,0 +1,128 @@
+package vercel_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+func testAccTeamExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		_, err := testClient().GetTeam(context.TODO(), rs.Primary.ID)
+		return err
+	}
+}
+
+func testAccTeamDestroy(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		_, err := testClient().GetTeam(context.TODO(), rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("expected not_found error, but got no error")
+		}
+		if !client.NotFound(err) {
+			return fmt.Errorf("Unexpected error checking for deleted team: %s", err)
+		}
+
+		return nil
+	}
+}
+
+func TestAcc_Team(t *testing.T) {
+	slug := "test-acc-team-" + acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccTeamDestroy("vercel_team.test"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamConfig(slug),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccTeamExists("vercel_team.test"),
+					resource.TestCheckResourceAttr("vercel_team.test", "slug", slug),
+					resource.TestCheckResourceAttr("vercel_team.test", "name", slug),
+					resource.TestCheckNoResourceAttr("vercel_team.test", "description"),
+					resource.TestCheckNoResourceAttr("vercel_team.test", "default_vercel_authentication"),
+					resource.TestCheckResourceAttrPair("data.vercel_team.test", "id", "vercel_team.test", "id"),
+					resource.TestCheckResourceAttrPair("data.vercel_team.test", "name", "vercel_team.test", "name"),
+				),
+			},
+			{
+				ResourceName:      "vercel_team.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTeamConfigUpdated(slug),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccTeamExists("vercel_team.test"),
+					resource.TestCheckResourceAttr("vercel_team.test", "slug", slug+"-renamed"),
+					resource.TestCheckResourceAttr("vercel_team.test", "name", "Terraform Acceptance Tests"),
+					resource.TestCheckResourceAttr("vercel_team.test", "description", "A team managed by terraform"),
+					resource.TestCheckResourceAttrSet("vercel_team.test", "avatar_id"),
+					resource.TestCheckResourceAttr("vercel_team.test", "default_vercel_authentication.protect_production", "true"),
+					resource.TestCheckResourceAttr("data.vercel_team.test", "description", "A team managed by terraform"),
+					resource.TestCheckResourceAttr("data.vercel_team.test", "default_vercel_authentication.protect_production", "true"),
+				),
+			},
+			{
+				ResourceName:            "vercel_team.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"avatar"},
+			},
+		},
+	})
+}
+
+func testAccTeamConfig(slug string) string {
+	return fmt.Sprintf(`
+resource "vercel_team" "test" {
+  slug = "%s"
+}
+
+data "vercel_team" "test" {
+  slug = vercel_team.test.slug
+}
+`, slug)
+}
+
+func testAccTeamConfigUpdated(slug string) string {
+	return fmt.Sprintf(`
+resource "vercel_team" "test" {
+  slug        = "%s-renamed"
+  name        = "Terraform Acceptance Tests"
+  description = "A team managed by terraform"
+  avatar      = "examples/one/windows_line_ending.png"
+  default_vercel_authentication = {
+    protect_production = true
+  }
+}
+
+data "vercel_team" "test" {
+  slug = vercel_team.test.slug
+}
+`, slug)
+}
@@ -1,6 +1,9 @@
 package vercel
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
 
 func toPtr[T any](v T) *T {
 	return &v
@@ -54,3 +57,49 @@ func toTeamID(v string) types.String {
 	}
 	return types.StringValue(v)
 }
+
+// fromOptionalString converts a string that Vercel leaves empty when it is not set.
+func fromOptionalString(v string) types.String {
+	if v == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(v)
+}
+
+func toStringList(values []string) types.List {
+	elements := []attr.Value{}
+	for _, v := range values {
+		elements = append(elements, types.StringValue(v))
+	}
+	return types.ListValueMust(types.StringType, elements)
+}
+
+// toStrings converts a set of strings from terraform into the strings sent to Vercel.
+func toStrings(values []types.String) []string {
+	out := []string{}
+	for _, v := range values {
+		out = append(out, v.ValueString())
+	}
+	return out
+}
+
+// fromStrings converts a list of strings that Vercel leaves empty when it is not set.
+func fromStrings(values []string) []types.String {
+	var out []types.String
+	for _, v := range values {
+		out = append(out, types.StringValue(v))
+	}
+	return out
+}
+
+// toOptionalStringSet converts a list of strings that Vercel leaves empty when it is not set.
+func toOptionalStringSet(values []string) types.Set {
+	if len(values) == 0 {
+		return types.SetNull(types.StringType)
+	}
+	elements := []attr.Value{}
+	for _, v := range values {
+		elements = append(elements, types.StringValue(v))
+	}
+	return types.SetValueMust(types.StringType, elements)
+}
@@ -0,0 +1,44 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// stringNotOneOf rejects any of the given items, regardless of their case.
+func stringNotOneOf(items ...string) validatorStringNotOneOf {
+	return validatorStringNotOneOf{
+		Items: items,
+	}
+}
+
+type validatorStringNotOneOf struct {
+	Items []string
+}
+
+func (v validatorStringNotOneOf) Description(ctx context.Context) string {
+	return fmt.Sprintf("Item must not be one of %s", strings.Join(v.Items, " "))
+}
+func (v validatorStringNotOneOf) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("Item must not be one of `%s`", strings.Join(v.Items, "` `"))
+}
+
+func (v validatorStringNotOneOf) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	for _, i := range v.Items {
+		if strings.EqualFold(i, req.ConfigValue.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid value provided",
+				fmt.Sprintf("Item must not be one of %s, got: %s.", strings.Join(v.Items, ", "), req.ConfigValue.ValueString()),
+			)
+			return
+		}
+	}
+}
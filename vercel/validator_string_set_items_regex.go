@@ -0,0 +1,56 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func stringSetItemsRegex(re *regexp.Regexp, errorMessage string) validatorStringSetItemsRegex {
+	return validatorStringSetItemsRegex{
+		Re:           re,
+		ErrorMessage: errorMessage,
+	}
+}
+
+type validatorStringSetItemsRegex struct {
+	Re           *regexp.Regexp
+	ErrorMessage string
+}
+
+func (v validatorStringSetItemsRegex) Description(ctx context.Context) string {
+	return v.ErrorMessage
+}
+func (v validatorStringSetItemsRegex) MarkdownDescription(ctx context.Context) string {
+	return v.ErrorMessage
+}
+
+func (v validatorStringSetItemsRegex) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	for _, i := range req.ConfigValue.Elements() {
+		var item types.String
+		diags := tfsdk.ValueAs(ctx, i, &item)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			return
+		}
+		if item.IsUnknown() || item.IsNull() {
+			continue
+		}
+		if !v.Re.MatchString(item.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid value provided",
+				fmt.Sprintf("%s, got %s", v.ErrorMessage, item.ValueString()),
+			)
+			return
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package vercel
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func stringValidRegex() validatorStringValidRegex {
+	return validatorStringValidRegex{}
+}
+
+type validatorStringValidRegex struct{}
+
+func (v validatorStringValidRegex) Description(ctx context.Context) string {
+	return "Value must be a valid regular expression"
+}
+func (v validatorStringValidRegex) MarkdownDescription(ctx context.Context) string {
+	return "Value must be a valid [RE2](https://github.com/google/re2/wiki/Syntax) regular expression"
+}
+
+func (v validatorStringValidRegex) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+	_, err := regexp.Compile(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid regular expression",
+			"The value could not be parsed as a regular expression: "+err.Error(),
+		)
+		return
+	}
+}